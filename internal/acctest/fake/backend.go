@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fake implements an in-process, stateful fake of a subset of the AWS APIs.
+//
+// A Backend is an http.RoundTripper that dispatches each AWS API request to a
+// per-service handler based on the request's Signature Version 4 credential scope.
+// Handlers keep simple in-memory state so that resources can be created, read,
+// updated, imported and deleted without AWS credentials or recorded interactions.
+//
+// Handlers for additional services can be added with RegisterService, typically
+// from a service package's tests.
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID returned by the fake backend.
+	DefaultAccountID = "123456789012"
+	// DefaultPartition is the AWS partition used in ARNs returned by the fake backend.
+	DefaultPartition = "aws"
+	// DefaultRegion is the AWS Region used when a request's Region cannot be determined.
+	DefaultRegion = "us-west-2" // lintignore:AWSAT003
+)
+
+// ServiceFactory returns a new handler for a service's API.
+// The handler is called with the Backend's lock not held and must synchronize access to its own state.
+type ServiceFactory func(*Backend) http.Handler
+
+var registry = struct {
+	lock      sync.Mutex
+	factories map[string]ServiceFactory
+}{
+	factories: make(map[string]ServiceFactory),
+}
+
+// RegisterService registers a handler factory for the service with the specified Signature Version 4 signing name, e.g. "s3".
+// Registering a factory for a signing name replaces any previously registered factory.
+// Factories must be registered before a Backend is created.
+func RegisterService(signingName string, factory ServiceFactory) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.factories[signingName] = factory
+}
+
+func init() {
+	RegisterService("dynamodb", newDynamoDBService)
+	RegisterService("iam", newIAMService)
+	RegisterService("s3", newS3Service)
+	RegisterService("sns", newSNSService)
+	RegisterService("sqs", newSQSService)
+	RegisterService("ssm", newSSMService)
+	RegisterService("sts", newSTSService)
+}
+
+// Backend is an in-process fake AWS backend.
+type Backend struct {
+	AccountID string
+	Partition string
+
+	lock     sync.Mutex
+	requests []string
+	services map[string]http.Handler
+}
+
+var _ http.RoundTripper = &Backend{}
+
+// New returns a new Backend with handlers for all registered services.
+func New() *Backend {
+	b := &Backend{
+		AccountID: DefaultAccountID,
+		Partition: DefaultPartition,
+		services:  make(map[string]http.Handler),
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for name, factory := range registry.factories {
+		b.services[name] = factory(b)
+	}
+
+	return b
+}
+
+// HTTPClient returns an http.Client that sends all requests to the Backend.
+func (b *Backend) HTTPClient() *http.Client {
+	return &http.Client{Transport: b}
+}
+
+// Requests returns the "service:operation" identifiers of all requests handled, in order.
+func (b *Backend) Requests() []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return append([]string(nil), b.requests...)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (b *Backend) RoundTrip(r *http.Request) (*http.Response, error) {
+	service, region := credentialScope(r)
+
+	b.lock.Lock()
+	handler, ok := b.services[service]
+	b.requests = append(b.requests, service+":"+operationName(r))
+	b.lock.Unlock()
+
+	w := httptest.NewRecorder()
+	r = r.WithContext(withRequestInfo(r.Context(), requestInfo{
+		accountID: b.AccountID,
+		partition: b.Partition,
+		region:    region,
+		service:   service,
+	}))
+
+	if !ok {
+		writeUnsupportedService(w, r, service)
+	} else {
+		handler.ServeHTTP(w, r)
+	}
+
+	resp := w.Result()
+	resp.Request = r
+
+	return resp, nil
+}
+
+// credentialScope returns the signing name and Region from a request's Signature Version 4 Authorization header.
+// e.g. "AWS4-HMAC-SHA256 Credential=AKID/20250101/us-west-2/s3/aws4_request, ...".
+func credentialScope(r *http.Request) (string, string) {
+	_, credential, ok := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+	if !ok {
+		return "", DefaultRegion
+	}
+	credential, _, _ = strings.Cut(credential, ",")
+
+	// AKID/date/region/service/aws4_request.
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 {
+		return "", DefaultRegion
+	}
+
+	return parts[3], parts[2]
+}
+
+// operationName returns a best-effort name for the API operation invoked by a request.
+func operationName(r *http.Request) string {
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		_, op, _ := strings.Cut(v, ".")
+		return op
+	}
+	if v := r.URL.Query().Get("Action"); v != "" {
+		return v
+	}
+	// Query protocol services (e.g. IAM, SNS and STS) send the action in a form-encoded body.
+	// Parsing the form consumes the body, so parse a copy and leave the original readable by the handler.
+	if r.Body != nil && strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		body, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err == nil {
+			clone := r.Clone(r.Context())
+			clone.Body = io.NopCloser(bytes.NewReader(body))
+			if v, err := QueryAction(clone); err == nil && v != "" {
+				return v
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+}
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+)
+
+func testConfig(b *fake.Backend) aws.Config {
+	return aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("AKIAFAKE", "secret", ""),
+		HTTPClient:  b.HTTPClient(),
+		Region:      "us-west-2", // lintignore:AWSAT003
+	}
+}
+
+func TestBackendSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sts.NewFromConfig(testConfig(fake.New()))
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), fake.DefaultAccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestBackendS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := s3.NewFromConfig(testConfig(fake.New()))
+	bucket := aws.String("tf-acc-test-bucket")
+
+	if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: bucket,
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+		},
+	}); err != nil {
+		t.Fatalf("CreateBucket: %s", err)
+	}
+
+	head, err := conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: bucket})
+	if err != nil {
+		t.Fatalf("HeadBucket: %s", err)
+	}
+	if got, want := aws.ToString(head.BucketRegion), "us-west-2"; got != want { // lintignore:AWSAT003
+		t.Errorf("BucketRegion = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: bucket})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		t.Errorf("GetBucketPolicy: expected NoSuchBucketPolicy, got %v", err)
+	}
+
+	if _, err := conn.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket: bucket,
+		Tagging: &s3types.Tagging{
+			TagSet: []s3types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+		},
+	}); err != nil {
+		t.Fatalf("PutBucketTagging: %s", err)
+	}
+
+	tags, err := conn.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: bucket})
+	if err != nil {
+		t.Fatalf("GetBucketTagging: %s", err)
+	}
+	if got, want := len(tags.TagSet), 1; got != want {
+		t.Fatalf("len(TagSet) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(tags.TagSet[0].Value), "value1"; got != want {
+		t.Errorf("TagSet[0].Value = %q, want %q", got, want)
+	}
+
+	versioning, err := conn.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: bucket})
+	if err != nil {
+		t.Fatalf("GetBucketVersioning: %s", err)
+	}
+	if versioning.Status != "" {
+		t.Errorf("Status = %q, want empty", versioning.Status)
+	}
+
+	if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: bucket,
+		Key:    aws.String("dir/key"),
+		Body:   strings.NewReader("Hello, world"),
+	}); err != nil {
+		t.Fatalf("PutObject: %s", err)
+	}
+
+	object, err := conn.GetObject(ctx, &s3.GetObjectInput{Bucket: bucket, Key: aws.String("dir/key")})
+	if err != nil {
+		t.Fatalf("GetObject: %s", err)
+	}
+	body, err := io.ReadAll(object.Body)
+	object.Body.Close()
+	if err != nil {
+		t.Fatalf("reading object body: %s", err)
+	}
+	if got, want := string(body), "Hello, world"; got != want {
+		t.Errorf("object body = %q, want %q", got, want)
+	}
+
+	list, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: bucket, Prefix: aws.String("dir/")})
+	if err != nil {
+		t.Fatalf("ListObjectsV2: %s", err)
+	}
+	if got, want := len(list.Contents), 1; got != want {
+		t.Errorf("len(Contents) = %d, want %d", got, want)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: bucket})
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("DeleteBucket: expected BucketNotEmpty, got %v", err)
+	}
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: bucket, Key: aws.String("dir/key")}); err != nil {
+		t.Fatalf("DeleteObject: %s", err)
+	}
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: bucket}); err != nil {
+		t.Fatalf("DeleteBucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: bucket})
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		t.Errorf("HeadBucket: expected HTTP status 404, got %v", err)
+	}
+}
+
+func TestBackendIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := iam.NewFromConfig(testConfig(fake.New()))
+	name := aws.String("tf-acc-test-role")
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 name,
+		Tags:                     []iamtypes.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	output, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: name})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(output.Role.Arn), "arn:aws:iam::123456789012:role/tf-acc-test-role"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+	if got, want := len(output.Role.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: name}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: name})
+	if target := (*iamtypes.NoSuchEntityException)(nil); !errors.As(err, &target) {
+		t.Errorf("GetRole: expected NoSuchEntityException, got %v", err)
+	}
+}
+
+func TestBackendSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sns.NewFromConfig(testConfig(fake.New()))
+
+	topic, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("tf-acc-test-topic"),
+		Tags: []snstypes.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+
+	if _, err := conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       topic.TopicArn,
+	}); err != nil {
+		t.Fatalf("SetTopicAttributes: %s", err)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: topic.TopicArn})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &sns.ListTagsForResourceInput{ResourceArn: topic.TopicArn})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+	if got, want := len(tags.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: topic.TopicArn}); err != nil {
+		t.Fatalf("DeleteTopic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: topic.TopicArn})
+	if target := (*snstypes.NotFoundException)(nil); !errors.As(err, &target) {
+		t.Errorf("GetTopicAttributes: expected NotFoundException, got %v", err)
+	}
+}
+
+func TestBackendSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sqs.NewFromConfig(testConfig(fake.New()))
+
+	queue, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		Attributes: map[string]string{"VisibilityTimeout": "60"},
+		QueueName:  aws.String("tf-acc-test-queue"),
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+		QueueUrl:       queue.QueueUrl,
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["VisibilityTimeout"], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes["QueueArn"], "arn:aws:sqs:us-west-2:123456789012:tf-acc-test-queue"; got != want { // lintignore:AWSAT003,AWSAT005
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: queue.QueueUrl}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: queue.QueueUrl})
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueAttributes: expected AWS.SimpleQueueService.NonExistentQueue, got %v", err)
+	}
+}
+
+func TestBackendSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := ssm.NewFromConfig(testConfig(fake.New()))
+	name := aws.String("/tf-acc-test/parameter")
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  name,
+		Type:  ssmtypes.ParameterTypeSecureString,
+		Value: aws.String("secret"),
+	}); err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  name,
+		Type:  ssmtypes.ParameterTypeSecureString,
+		Value: aws.String("secret"),
+	})
+	if target := (*ssmtypes.ParameterAlreadyExists)(nil); !errors.As(err, &target) {
+		t.Errorf("PutParameter: expected ParameterAlreadyExists, got %v", err)
+	}
+
+	parameter, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: name, WithDecryption: aws.Bool(true)})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+	if got, want := aws.ToString(parameter.Parameter.Value), "secret"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+
+	metadata, err := conn.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{{Key: aws.String("Name"), Option: aws.String("Equals"), Values: []string{aws.ToString(name)}}},
+	})
+	if err != nil {
+		t.Fatalf("DescribeParameters: %s", err)
+	}
+	if got, want := len(metadata.Parameters), 1; got != want {
+		t.Fatalf("len(Parameters) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(metadata.Parameters[0].KeyId), "alias/aws/ssm"; got != want {
+		t.Errorf("KeyId = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: name}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: name})
+	if target := (*ssmtypes.ParameterNotFound)(nil); !errors.As(err, &target) {
+		t.Errorf("GetParameter: expected ParameterNotFound, got %v", err)
+	}
+}
+
+func TestBackendDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := dynamodb.NewFromConfig(testConfig(fake.New()))
+	name := aws.String("tf-acc-test-table")
+
+	if _, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{{AttributeName: aws.String("pk"), AttributeType: dynamodbtypes.ScalarAttributeTypeS}},
+		BillingMode:          dynamodbtypes.BillingModePayPerRequest,
+		KeySchema:            []dynamodbtypes.KeySchemaElement{{AttributeName: aws.String("pk"), KeyType: dynamodbtypes.KeyTypeHash}},
+		TableName:            name,
+	}); err != nil {
+		t.Fatalf("CreateTable: %s", err)
+	}
+
+	output, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: name})
+	if err != nil {
+		t.Fatalf("DescribeTable: %s", err)
+	}
+	if got, want := output.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+	if got, want := output.Table.BillingModeSummary.BillingMode, dynamodbtypes.BillingModePayPerRequest; got != want {
+		t.Errorf("BillingMode = %q, want %q", got, want)
+	}
+
+	if _, err := conn.UpdateTimeToLive(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName:               name,
+		TimeToLiveSpecification: &dynamodbtypes.TimeToLiveSpecification{AttributeName: aws.String("expires"), Enabled: aws.Bool(true)},
+	}); err != nil {
+		t.Fatalf("UpdateTimeToLive: %s", err)
+	}
+
+	ttl, err := conn.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: name})
+	if err != nil {
+		t.Fatalf("DescribeTimeToLive: %s", err)
+	}
+	if got, want := ttl.TimeToLiveDescription.TimeToLiveStatus, dynamodbtypes.TimeToLiveStatusEnabled; got != want {
+		t.Errorf("TimeToLiveStatus = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: name}); err != nil {
+		t.Fatalf("DeleteTable: %s", err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: name})
+	if target := (*dynamodbtypes.ResourceNotFoundException)(nil); !errors.As(err, &target) {
+		t.Errorf("DescribeTable: expected ResourceNotFoundException, got %v", err)
+	}
+}
+
+func TestBackendRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := fake.New()
+	conn := sqs.NewFromConfig(testConfig(b))
+
+	_, err := conn.ListQueues(ctx, &sqs.ListQueuesInput{})
+	if err != nil {
+		t.Fatalf("ListQueues: %s", err)
+	}
+
+	if got, want := b.Requests(), []string{"sqs:ListQueues"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Requests() = %v, want %v", got, want)
+	}
+}
+
+func TestBackendRequestsQueryProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := fake.New()
+	conn := iam.NewFromConfig(testConfig(b))
+	name := aws.String("tf-acc-test-role")
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 name,
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	// The handler must still see the request body after the action has been logged.
+	output, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: name})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(output.Role.RoleName), aws.ToString(name); got != want {
+		t.Errorf("RoleName = %q, want %q", got, want)
+	}
+
+	if got, want := b.Requests(), []string{"iam:CreateRole", "iam:GetRole"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Requests() = %v, want %v", got, want)
+	}
+}
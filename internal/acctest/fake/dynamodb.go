@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const dynamoDBErrorNamespace = "com.amazonaws.dynamodb.v20120810#"
+
+// dynamoDBTable holds a table's description in the API's JSON representation.
+type dynamoDBTable struct {
+	description         map[string]any
+	pointInTimeRecovery bool
+	tags                map[string]string
+	timeToLiveAttribute string
+	timeToLiveEnabled   bool
+}
+
+type dynamoDBService struct {
+	lock   sync.Mutex
+	tables map[string]*dynamoDBTable // Keyed by table name.
+}
+
+func newDynamoDBService(*Backend) http.Handler {
+	return &dynamoDBService{
+		tables: make(map[string]*dynamoDBTable),
+	}
+}
+
+type dynamoDBTag struct {
+	Key   string
+	Value string
+}
+
+func (s *dynamoDBService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input map[string]any
+	if err := ReadJSON(r, &input); err != nil {
+		WriteJSONError(w, r, http.StatusBadRequest, dynamoDBErrorNamespace+"ValidationException", err.Error())
+		return
+	}
+
+	tableName, _ := input["TableName"].(string)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch op := JSONOperation(r); op {
+	case "CreateTable":
+		if _, ok := s.tables[tableName]; ok {
+			WriteJSONError(w, r, http.StatusBadRequest, dynamoDBErrorNamespace+"ResourceInUseException", fmt.Sprintf("Table already exists: %s", tableName))
+			return
+		}
+
+		table := &dynamoDBTable{
+			description: newDynamoDBTableDescription(r, input),
+			tags:        make(map[string]string),
+		}
+		for _, tag := range dynamoDBTagsFromInput(input["Tags"]) {
+			table.tags[tag.Key] = tag.Value
+		}
+		s.tables[tableName] = table
+
+		WriteJSON(w, r, map[string]any{"TableDescription": table.description})
+
+	case "DeleteTable":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		delete(s.tables, tableName)
+		description := maps.Clone(table.description)
+		description["TableStatus"] = "DELETING"
+
+		WriteJSON(w, r, map[string]any{"TableDescription": description})
+
+	case "DescribeContinuousBackups":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		s.writeContinuousBackups(w, r, table)
+
+	case "DescribeTable":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		WriteJSON(w, r, map[string]any{"Table": table.description})
+
+	case "DescribeTimeToLive":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		description := map[string]any{"TimeToLiveStatus": "DISABLED"}
+		if table.timeToLiveEnabled {
+			description = map[string]any{
+				"AttributeName":    table.timeToLiveAttribute,
+				"TimeToLiveStatus": "ENABLED",
+			}
+		}
+
+		WriteJSON(w, r, map[string]any{"TimeToLiveDescription": description})
+
+	case "ListTables":
+		WriteJSON(w, r, map[string]any{"TableNames": slices.Sorted(maps.Keys(s.tables))})
+
+	case "ListTagsOfResource":
+		table, ok := s.findTableByARN(w, r, input["ResourceArn"])
+		if !ok {
+			return
+		}
+
+		tags := []dynamoDBTag{}
+		for _, key := range slices.Sorted(maps.Keys(table.tags)) {
+			tags = append(tags, dynamoDBTag{Key: key, Value: table.tags[key]})
+		}
+
+		WriteJSON(w, r, map[string]any{"Tags": tags})
+
+	case "TagResource":
+		table, ok := s.findTableByARN(w, r, input["ResourceArn"])
+		if !ok {
+			return
+		}
+
+		for _, tag := range dynamoDBTagsFromInput(input["Tags"]) {
+			table.tags[tag.Key] = tag.Value
+		}
+		WriteJSON(w, r, struct{}{})
+
+	case "UntagResource":
+		table, ok := s.findTableByARN(w, r, input["ResourceArn"])
+		if !ok {
+			return
+		}
+
+		keys, _ := input["TagKeys"].([]any)
+		for _, key := range keys {
+			if key, ok := key.(string); ok {
+				delete(table.tags, key)
+			}
+		}
+		WriteJSON(w, r, struct{}{})
+
+	case "UpdateContinuousBackups":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		if v, ok := input["PointInTimeRecoverySpecification"].(map[string]any); ok {
+			table.pointInTimeRecovery, _ = v["PointInTimeRecoveryEnabled"].(bool)
+		}
+
+		s.writeContinuousBackups(w, r, table)
+
+	case "UpdateTable":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		updateDynamoDBTableDescription(table.description, input)
+
+		WriteJSON(w, r, map[string]any{"TableDescription": table.description})
+
+	case "UpdateTimeToLive":
+		table, ok := s.findTable(w, r, tableName)
+		if !ok {
+			return
+		}
+
+		specification, _ := input["TimeToLiveSpecification"].(map[string]any)
+		table.timeToLiveAttribute, _ = specification["AttributeName"].(string)
+		table.timeToLiveEnabled, _ = specification["Enabled"].(bool)
+
+		WriteJSON(w, r, map[string]any{"TimeToLiveSpecification": specification})
+
+	default:
+		writeUnsupportedOperation(w, r, op)
+	}
+}
+
+func (s *dynamoDBService) writeContinuousBackups(w http.ResponseWriter, r *http.Request, table *dynamoDBTable) {
+	status := "DISABLED"
+	if table.pointInTimeRecovery {
+		status = "ENABLED"
+	}
+
+	WriteJSON(w, r, map[string]any{
+		"ContinuousBackupsDescription": map[string]any{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": map[string]any{
+				"PointInTimeRecoveryStatus": status,
+			},
+		},
+	})
+}
+
+func (s *dynamoDBService) findTable(w http.ResponseWriter, r *http.Request, tableName string) (*dynamoDBTable, bool) {
+	table, ok := s.tables[tableName]
+	if !ok {
+		WriteJSONError(w, r, http.StatusBadRequest, dynamoDBErrorNamespace+"ResourceNotFoundException", fmt.Sprintf("Requested resource not found: Table: %s not found", tableName))
+	}
+
+	return table, ok
+}
+
+func (s *dynamoDBService) findTableByARN(w http.ResponseWriter, r *http.Request, v any) (*dynamoDBTable, bool) {
+	tableARN, _ := v.(string)
+	for _, table := range s.tables {
+		if table.description["TableArn"] == tableARN {
+			return table, true
+		}
+	}
+
+	WriteJSONError(w, r, http.StatusBadRequest, dynamoDBErrorNamespace+"ResourceNotFoundException", fmt.Sprintf("Requested resource not found: ResourceArn: %s not found", tableARN))
+
+	return nil, false
+}
+
+func dynamoDBTagsFromInput(v any) []dynamoDBTag {
+	var tags []dynamoDBTag
+
+	list, _ := v.([]any)
+	for _, v := range list {
+		m, _ := v.(map[string]any)
+		key, _ := m["Key"].(string)
+		value, _ := m["Value"].(string)
+		tags = append(tags, dynamoDBTag{Key: key, Value: value})
+	}
+
+	return tags
+}
+
+// newDynamoDBTableDescription returns the description of a new table created by the specified CreateTable input.
+// Tables are created ACTIVE.
+func newDynamoDBTableDescription(r *http.Request, input map[string]any) map[string]any {
+	tableName, _ := input["TableName"].(string)
+	tableARN := ARN(r, "dynamodb", "table/"+tableName)
+
+	description := map[string]any{
+		"AttributeDefinitions":      input["AttributeDefinitions"],
+		"CreationDateTime":          float64(time.Now().UnixMilli()) / 1000,
+		"DeletionProtectionEnabled": input["DeletionProtectionEnabled"] == true,
+		"ItemCount":                 0,
+		"KeySchema":                 input["KeySchema"],
+		"TableArn":                  tableARN,
+		"TableId":                   newRequestID(),
+		"TableName":                 tableName,
+		"TableSizeBytes":            0,
+		"TableStatus":               "ACTIVE",
+	}
+
+	for _, key := range []string{"LocalSecondaryIndexes", "OnDemandThroughput", "StreamSpecification"} {
+		if v, ok := input[key]; ok {
+			description[key] = v
+		}
+	}
+
+	if v, ok := input["GlobalSecondaryIndexes"].([]any); ok {
+		indexes := make([]any, 0, len(v))
+		for _, v := range v {
+			indexes = append(indexes, newDynamoDBGlobalSecondaryIndexDescription(tableARN, v))
+		}
+		description["GlobalSecondaryIndexes"] = indexes
+	}
+
+	updateDynamoDBTableDescription(description, input)
+
+	return description
+}
+
+func newDynamoDBGlobalSecondaryIndexDescription(tableARN string, v any) map[string]any {
+	index := maps.Clone(v.(map[string]any))
+	indexName, _ := index["IndexName"].(string)
+
+	index["IndexArn"] = tableARN + "/index/" + indexName
+	index["IndexStatus"] = "ACTIVE"
+	index["ItemCount"] = 0
+	index["IndexSizeBytes"] = 0
+	if _, ok := index["ProvisionedThroughput"]; !ok {
+		index["ProvisionedThroughput"] = map[string]any{
+			"NumberOfDecreasesToday": 0,
+			"ReadCapacityUnits":      0,
+			"WriteCapacityUnits":     0,
+		}
+	}
+
+	return index
+}
+
+// updateDynamoDBTableDescription applies the billing, throughput and other table-level settings
+// from a CreateTable or UpdateTable input to a table description.
+func updateDynamoDBTableDescription(description, input map[string]any) {
+	if v, ok := input["AttributeDefinitions"]; ok {
+		description["AttributeDefinitions"] = v
+	}
+
+	billingMode, _ := input["BillingMode"].(string)
+	if billingMode == "" {
+		if v, ok := description["BillingModeSummary"].(map[string]any); ok {
+			billingMode, _ = v["BillingMode"].(string)
+		}
+	}
+	if billingMode == "" {
+		billingMode = "PROVISIONED"
+	}
+	description["BillingModeSummary"] = map[string]any{
+		"BillingMode":                       billingMode,
+		"LastUpdateToPayPerRequestDateTime": float64(time.Now().UnixMilli()) / 1000,
+	}
+
+	throughput := map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+	if v, ok := description["ProvisionedThroughput"].(map[string]any); ok {
+		throughput = v
+	}
+	if v, ok := input["ProvisionedThroughput"].(map[string]any); ok && billingMode == "PROVISIONED" {
+		maps.Copy(throughput, v)
+	}
+	if billingMode == "PAY_PER_REQUEST" {
+		throughput["ReadCapacityUnits"] = 0
+		throughput["WriteCapacityUnits"] = 0
+	}
+	description["ProvisionedThroughput"] = throughput
+
+	if v, ok := input["DeletionProtectionEnabled"].(bool); ok {
+		description["DeletionProtectionEnabled"] = v
+	}
+	if v, ok := input["OnDemandThroughput"]; ok {
+		description["OnDemandThroughput"] = v
+	}
+	if v, ok := input["StreamSpecification"].(map[string]any); ok {
+		description["StreamSpecification"] = v
+		if v["StreamEnabled"] == true {
+			label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+			description["LatestStreamArn"] = fmt.Sprintf("%s/stream/%s", description["TableArn"], label)
+			description["LatestStreamLabel"] = label
+		} else {
+			delete(description, "StreamSpecification")
+		}
+	}
+	if v, ok := input["TableClass"].(string); ok {
+		description["TableClassSummary"] = map[string]any{"TableClass": v}
+	}
+
+	updates, _ := input["GlobalSecondaryIndexUpdates"].([]any)
+	indexes, _ := description["GlobalSecondaryIndexes"].([]any)
+	for _, v := range updates {
+		update, _ := v.(map[string]any)
+
+		if v, ok := update["Create"]; ok {
+			tableARN, _ := description["TableArn"].(string)
+			indexes = append(indexes, newDynamoDBGlobalSecondaryIndexDescription(tableARN, v))
+		}
+		if v, ok := update["Delete"].(map[string]any); ok {
+			indexes = slices.DeleteFunc(indexes, func(index any) bool {
+				return index.(map[string]any)["IndexName"] == v["IndexName"]
+			})
+		}
+		if v, ok := update["Update"].(map[string]any); ok {
+			for _, index := range indexes {
+				if index := index.(map[string]any); index["IndexName"] == v["IndexName"] {
+					for _, key := range []string{"OnDemandThroughput", "ProvisionedThroughput"} {
+						if v, ok := v[key]; ok {
+							index[key] = v
+						}
+					}
+				}
+			}
+		}
+	}
+	if len(indexes) > 0 {
+		description["GlobalSecondaryIndexes"] = indexes
+	} else {
+		delete(description, "GlobalSecondaryIndexes")
+	}
+}
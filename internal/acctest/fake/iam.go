@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const iamNamespace = "https://iam.amazonaws.com/doc/2010-05-08/"
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	id                       string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	tags                     map[string]string
+}
+
+type iamService struct {
+	lock  sync.Mutex
+	roles map[string]*iamRole
+}
+
+func newIAMService(*Backend) http.Handler {
+	return &iamService{
+		roles: make(map[string]*iamRole),
+	}
+}
+
+type iamTag struct {
+	Key   string
+	Value string
+}
+
+type iamRoleShape struct {
+	Arn                      string
+	AssumeRolePolicyDocument string `xml:",omitempty"`
+	CreateDate               string
+	Description              string `xml:",omitempty"`
+	MaxSessionDuration       int    `xml:",omitempty"`
+	Path                     string
+	PermissionsBoundary      *struct {
+		PermissionsBoundaryArn  string
+		PermissionsBoundaryType string
+	} `xml:",omitempty"`
+	RoleID   string `xml:"RoleId"`
+	RoleName string
+	Tags     []iamTag `xml:"Tags>member,omitempty"`
+}
+
+func (role *iamRole) shape() iamRoleShape {
+	v := iamRoleShape{
+		Arn:                      role.arn,
+		AssumeRolePolicyDocument: url.QueryEscape(role.assumeRolePolicyDocument),
+		CreateDate:               role.createDate.Format(time.RFC3339),
+		Description:              role.description,
+		MaxSessionDuration:       role.maxSessionDuration,
+		Path:                     role.path,
+		RoleID:                   role.id,
+		RoleName:                 role.name,
+		Tags:                     iamTags(role.tags),
+	}
+
+	if role.permissionsBoundary != "" {
+		v.PermissionsBoundary = &struct {
+			PermissionsBoundaryArn  string
+			PermissionsBoundaryType string
+		}{
+			PermissionsBoundaryArn:  role.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+func iamTags(tags map[string]string) []iamTag {
+	var v []iamTag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		v = append(v, iamTag{Key: key, Value: tags[key]})
+	}
+
+	return v
+}
+
+func (s *iamService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	action, err := QueryAction(r)
+	if err != nil {
+		WriteQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if action == "CreateRole" {
+		s.createRole(w, r)
+		return
+	}
+
+	// All other supported actions operate on an existing role.
+	name := r.Form.Get("RoleName")
+	role, ok := s.roles[name]
+	if !ok {
+		if !slices.Contains(iamRoleActions, action) {
+			writeUnsupportedOperation(w, r, action)
+			return
+		}
+		WriteQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role with name %s cannot be found.", name))
+		return
+	}
+
+	switch action {
+	case "AttachRolePolicy":
+		if policyARN := r.Form.Get("PolicyArn"); !slices.Contains(role.attachedPolicyARNs, policyARN) {
+			role.attachedPolicyARNs = append(role.attachedPolicyARNs, policyARN)
+		}
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "DeleteRole":
+		if len(role.attachedPolicyARNs) > 0 || len(role.inlinePolicies) > 0 {
+			WriteQueryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete entity, must detach all policies first.")
+			return
+		}
+		delete(s.roles, name)
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "DeleteRolePermissionsBoundary":
+		role.permissionsBoundary = ""
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "DeleteRolePolicy":
+		policyName := r.Form.Get("PolicyName")
+		if _, ok := role.inlinePolicies[policyName]; !ok {
+			WriteQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+			return
+		}
+		delete(role.inlinePolicies, policyName)
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "DetachRolePolicy":
+		policyARN := r.Form.Get("PolicyArn")
+		i := slices.Index(role.attachedPolicyARNs, policyARN)
+		if i < 0 {
+			WriteQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s was not found.", policyARN))
+			return
+		}
+		role.attachedPolicyARNs = slices.Delete(role.attachedPolicyARNs, i, i+1)
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "GetRole":
+		WriteQueryResult(w, action, iamNamespace, struct {
+			Role iamRoleShape
+		}{
+			Role: role.shape(),
+		})
+
+	case "GetRolePolicy":
+		policyName := r.Form.Get("PolicyName")
+		document, ok := role.inlinePolicies[policyName]
+		if !ok {
+			WriteQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", policyName))
+			return
+		}
+		WriteQueryResult(w, action, iamNamespace, struct {
+			PolicyDocument string
+			PolicyName     string
+			RoleName       string
+		}{
+			PolicyDocument: url.QueryEscape(document),
+			PolicyName:     policyName,
+			RoleName:       name,
+		})
+
+	case "ListAttachedRolePolicies":
+		type attachedPolicy struct {
+			PolicyArn  string
+			PolicyName string
+		}
+		var policies []attachedPolicy
+		for _, policyARN := range role.attachedPolicyARNs {
+			policies = append(policies, attachedPolicy{
+				PolicyArn:  policyARN,
+				PolicyName: policyARN[strings.LastIndexByte(policyARN, '/')+1:],
+			})
+		}
+		WriteQueryResult(w, action, iamNamespace, struct {
+			AttachedPolicies []attachedPolicy `xml:"AttachedPolicies>member"`
+			IsTruncated      bool
+		}{
+			AttachedPolicies: policies,
+		})
+
+	case "ListInstanceProfilesForRole":
+		WriteQueryResult(w, action, iamNamespace, struct {
+			InstanceProfiles []struct{} `xml:"InstanceProfiles>member"`
+			IsTruncated      bool
+		}{})
+
+	case "ListRolePolicies":
+		WriteQueryResult(w, action, iamNamespace, struct {
+			IsTruncated bool
+			PolicyNames []string `xml:"PolicyNames>member"`
+		}{
+			PolicyNames: slices.Sorted(maps.Keys(role.inlinePolicies)),
+		})
+
+	case "ListRoleTags":
+		WriteQueryResult(w, action, iamNamespace, struct {
+			IsTruncated bool
+			Tags        []iamTag `xml:"Tags>member"`
+		}{
+			Tags: iamTags(role.tags),
+		})
+
+	case "PutRolePermissionsBoundary":
+		role.permissionsBoundary = r.Form.Get("PermissionsBoundary")
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "PutRolePolicy":
+		role.inlinePolicies[r.Form.Get("PolicyName")] = r.Form.Get("PolicyDocument")
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "TagRole":
+		maps.Copy(role.tags, QueryMap(r, "Tags.member", "Key", "Value"))
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "UntagRole":
+		for _, key := range QueryList(r, "TagKeys.member") {
+			delete(role.tags, key)
+		}
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "UpdateAssumeRolePolicy":
+		role.assumeRolePolicyDocument = r.Form.Get("PolicyDocument")
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "UpdateRole":
+		if _, ok := r.Form["Description"]; ok {
+			role.description = r.Form.Get("Description")
+		}
+		if v := r.Form.Get("MaxSessionDuration"); v != "" {
+			role.maxSessionDuration, _ = strconv.Atoi(v)
+		}
+		WriteQueryResult(w, action, iamNamespace, nil)
+
+	case "UpdateRoleDescription":
+		role.description = r.Form.Get("Description")
+		WriteQueryResult(w, action, iamNamespace, struct {
+			Role iamRoleShape
+		}{
+			Role: role.shape(),
+		})
+	}
+}
+
+// iamRoleActions are the supported actions that operate on an existing role.
+var iamRoleActions = []string{
+	"AttachRolePolicy",
+	"DeleteRole",
+	"DeleteRolePermissionsBoundary",
+	"DeleteRolePolicy",
+	"DetachRolePolicy",
+	"GetRole",
+	"GetRolePolicy",
+	"ListAttachedRolePolicies",
+	"ListInstanceProfilesForRole",
+	"ListRolePolicies",
+	"ListRoleTags",
+	"PutRolePermissionsBoundary",
+	"PutRolePolicy",
+	"TagRole",
+	"UntagRole",
+	"UpdateAssumeRolePolicy",
+	"UpdateRole",
+	"UpdateRoleDescription",
+}
+
+func (s *iamService) createRole(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("RoleName")
+	if _, ok := s.roles[name]; ok {
+		WriteQueryError(w, http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf("Role with name %s already exists.", name))
+		return
+	}
+
+	path := r.Form.Get("Path")
+	if path == "" {
+		path = "/"
+	}
+	maxSessionDuration := 3600
+	if v := r.Form.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	role := &iamRole{
+		arn:                      GlobalARN(r, "iam", "role"+path+name),
+		assumeRolePolicyDocument: r.Form.Get("AssumeRolePolicyDocument"),
+		createDate:               time.Now().UTC().Truncate(time.Second),
+		description:              r.Form.Get("Description"),
+		id:                       "AROA" + strings.ToUpper(strings.ReplaceAll(newRequestID(), "-", ""))[:17],
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     path,
+		permissionsBoundary:      r.Form.Get("PermissionsBoundary"),
+		tags:                     QueryMap(r, "Tags.member", "Key", "Value"),
+	}
+	s.roles[name] = role
+
+	WriteQueryResult(w, "CreateRole", iamNamespace, struct {
+		Role iamRoleShape
+	}{
+		Role: role.shape(),
+	})
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type requestInfoKey struct{}
+
+// requestInfo holds the identity of the caller and the location of the request.
+type requestInfo struct {
+	accountID string
+	partition string
+	region    string
+	service   string
+}
+
+func withRequestInfo(ctx context.Context, v requestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, v)
+}
+
+func requestInfoFromContext(ctx context.Context) requestInfo {
+	v, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+	return v
+}
+
+// AccountID returns the AWS account ID of the caller of a request handled by a Backend.
+func AccountID(r *http.Request) string {
+	return requestInfoFromContext(r.Context()).accountID
+}
+
+// Region returns the AWS Region of a request handled by a Backend.
+func Region(r *http.Request) string {
+	return requestInfoFromContext(r.Context()).region
+}
+
+// ARN returns a regional ARN for a resource in the caller's account and Region.
+func ARN(r *http.Request, service, resource string) string {
+	v := requestInfoFromContext(r.Context())
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", v.partition, service, v.region, v.accountID, resource)
+}
+
+// GlobalARN returns an ARN for a global resource, e.g. an IAM role, in the caller's account.
+func GlobalARN(r *http.Request, service, resource string) string {
+	v := requestInfoFromContext(r.Context())
+	return fmt.Sprintf("arn:%s:%s::%s:%s", v.partition, service, v.accountID, resource)
+}
+
+func newRequestID() string {
+	id, _ := uuid.GenerateUUID()
+	return id
+}
+
+// JSONOperation returns the operation name from a JSON protocol request's X-Amz-Target header,
+// e.g. "Query" for "DynamoDB_20120810.Query".
+func JSONOperation(r *http.Request) string {
+	_, op, _ := strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+	return op
+}
+
+// ReadJSON decodes a JSON protocol request's body into v.
+func ReadJSON(r *http.Request, v any) error {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(b) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// WriteJSON writes a successful JSON protocol response.
+func WriteJSON(w http.ResponseWriter, r *http.Request, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		WriteJSONError(w, r, http.StatusInternalServerError, "InternalFailure", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	w.WriteHeader(http.StatusOK)
+	w.Write(b) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+// WriteJSONError writes a JSON protocol error response.
+func WriteJSONError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	b, _ := json.Marshal(map[string]string{
+		"__type":  code,
+		"message": message,
+	})
+
+	w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	w.Header().Set("X-Amzn-ErrorType", code)
+	w.WriteHeader(status)
+	w.Write(b) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+// QueryAction parses an AWS Query protocol request and returns its action name.
+func QueryAction(r *http.Request) (string, error) {
+	if err := r.ParseForm(); err != nil {
+		return "", err
+	}
+
+	return r.Form.Get("Action"), nil
+}
+
+// QueryList returns the values of a Query protocol list parameter, e.g. "TagKeys.member".
+func QueryList(r *http.Request, prefix string) []string {
+	var values []string
+	for i := 1; ; i++ {
+		v, ok := r.Form[fmt.Sprintf("%s.%d", prefix, i)]
+		if !ok {
+			break
+		}
+		values = append(values, v...)
+	}
+
+	return values
+}
+
+// QueryMap returns the entries of a Query protocol list of key/value structures, e.g. "Tags.member" with "Key" and "Value".
+func QueryMap(r *http.Request, prefix, key, value string) map[string]string {
+	m := make(map[string]string)
+	for i := 1; ; i++ {
+		k, ok := r.Form[fmt.Sprintf("%s.%d.%s", prefix, i, key)]
+		if !ok {
+			break
+		}
+		m[k[0]] = r.Form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, value))
+	}
+
+	return m
+}
+
+// WriteQueryResult writes a successful AWS Query protocol response.
+// v is encoded as the <Action>Result element and may be nil.
+func WriteQueryResult(w http.ResponseWriter, action, namespace string, v any) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, `<%[1]sResponse xmlns=%[2]q>`, action, namespace)
+	if v != nil {
+		if err := xml.NewEncoder(&sb).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: action + "Result"}}); err != nil {
+			WriteQueryError(w, http.StatusInternalServerError, "InternalFailure", err.Error())
+			return
+		}
+	}
+	fmt.Fprintf(&sb, `<ResponseMetadata><RequestId>%[1]s</RequestId></ResponseMetadata></%[2]sResponse>`, newRequestID(), action)
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, sb.String()) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+// WriteQueryError writes an AWS Query protocol error response.
+func WriteQueryError(w http.ResponseWriter, status int, code, message string) {
+	type errorResponse struct {
+		XMLName xml.Name `xml:"ErrorResponse"`
+		Error   struct {
+			Type    string
+			Code    string
+			Message string
+		}
+		RequestID string `xml:"RequestId"`
+	}
+
+	v := errorResponse{RequestID: newRequestID()}
+	v.Error.Type = "Sender"
+	if status >= http.StatusInternalServerError {
+		v.Error.Type = "Receiver"
+	}
+	v.Error.Code = code
+	v.Error.Message = message
+
+	b, _ := xml.Marshal(v)
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	w.Write(b) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+// WriteXML writes a successful REST-XML protocol response with v encoded as the named root element.
+func WriteXML(w http.ResponseWriter, r *http.Request, name string, v any) {
+	var sb strings.Builder
+
+	sb.WriteString(xml.Header)
+	if err := xml.NewEncoder(&sb).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+		WriteXMLError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, sb.String()) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+// WriteXMLError writes a REST-XML protocol error response.
+// Responses to HEAD requests have no body, matching AWS behavior.
+func WriteXMLError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	type errorResponse struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string
+		Message   string
+		RequestID string `xml:"RequestId"`
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	b, _ := xml.Marshal(errorResponse{Code: code, Message: message, RequestID: newRequestID()})
+	w.Write(b) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+}
+
+func writeUnsupportedService(w http.ResponseWriter, r *http.Request, service string) {
+	const code = "UnsupportedService"
+	message := fmt.Sprintf("fake backend: service %q is not supported", service)
+
+	if r.Header.Get("X-Amz-Target") != "" {
+		WriteJSONError(w, r, http.StatusBadRequest, code, message)
+	} else {
+		WriteXMLError(w, r, http.StatusBadRequest, code, message)
+	}
+}
+
+func writeUnsupportedOperation(w http.ResponseWriter, r *http.Request, operation string) {
+	const code = "UnsupportedOperation"
+	message := fmt.Sprintf("fake backend: operation %q is not supported", operation)
+
+	switch {
+	case r.Header.Get("X-Amz-Target") != "":
+		WriteJSONError(w, r, http.StatusBadRequest, code, message)
+	case r.Form != nil:
+		WriteQueryError(w, http.StatusBadRequest, code, message)
+	default:
+		WriteXMLError(w, r, http.StatusBadRequest, code, message)
+	}
+}
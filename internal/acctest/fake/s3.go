@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5" // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-md5 -- ETag values are MD5 digests
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	s3Namespace        = "http://s3.amazonaws.com/doc/2006-03-01/"
+	s3CanonicalOwnerID = "fakecanonicaluserid"
+)
+
+// s3Subresource describes how a bucket or object subresource, e.g. "?policy", behaves when it has not been set.
+// Either notFound, the error code returned by GET, or defaultBody, returned by GET, is set.
+type s3Subresource struct {
+	defaultBody string
+	notFound    string
+}
+
+var (
+	s3BucketSubresources = map[string]s3Subresource{
+		"accelerate": {defaultBody: `<AccelerateConfiguration/>`},
+		"acl": {defaultBody: `<AccessControlPolicy><Owner><ID>` + s3CanonicalOwnerID + `</ID></Owner><AccessControlList><Grant>` +
+			`<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + s3CanonicalOwnerID + `</ID></Grantee>` +
+			`<Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`},
+		"cors": {notFound: "NoSuchCORSConfiguration"},
+		"encryption": {defaultBody: `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault>` +
+			`<SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`},
+		"lifecycle":         {notFound: "NoSuchLifecycleConfiguration"},
+		"logging":           {defaultBody: `<BucketLoggingStatus/>`},
+		"notification":      {defaultBody: `<NotificationConfiguration/>`},
+		"object-lock":       {notFound: "ObjectLockConfigurationNotFoundError"},
+		"ownershipControls": {defaultBody: `<OwnershipControls><Rule><ObjectOwnership>BucketOwnerEnforced</ObjectOwnership></Rule></OwnershipControls>`},
+		"policy":            {notFound: "NoSuchBucketPolicy"},
+		"publicAccessBlock": {defaultBody: `<PublicAccessBlockConfiguration><BlockPublicAcls>true</BlockPublicAcls><IgnorePublicAcls>true</IgnorePublicAcls>` +
+			`<BlockPublicPolicy>true</BlockPublicPolicy><RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>`},
+		"replication":    {notFound: "ReplicationConfigurationNotFoundError"},
+		"requestPayment": {defaultBody: `<RequestPaymentConfiguration><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`},
+		"tagging":        {notFound: "NoSuchTagSet"},
+		"versioning":     {defaultBody: `<VersioningConfiguration/>`},
+		"website":        {notFound: "NoSuchWebsiteConfiguration"},
+	}
+
+	s3ObjectSubresources = map[string]s3Subresource{
+		"acl":        s3BucketSubresources["acl"],
+		"legal-hold": {notFound: "NoSuchObjectLockConfiguration"},
+		"retention":  {notFound: "NoSuchObjectLockConfiguration"},
+		"tagging":    {defaultBody: `<Tagging><TagSet/></Tagging>`},
+	}
+)
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     http.Header
+	subresources map[string][]byte
+}
+
+type s3Bucket struct {
+	creationDate time.Time
+	objects      map[string]*s3Object
+	region       string
+	subresources map[string][]byte
+}
+
+type s3Service struct {
+	lock    sync.Mutex
+	buckets map[string]*s3Bucket
+}
+
+func newS3Service(*Backend) http.Handler {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+// s3BucketAndKey returns the bucket name and object key addressed by a request,
+// supporting both virtual-hosted-style and path-style requests.
+func s3BucketAndKey(r *http.Request) (string, string) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	host := r.URL.Hostname()
+	for _, sep := range []string{".s3.", ".s3-"} {
+		if i := strings.Index(host, sep); i > 0 {
+			return host[:i], path
+		}
+	}
+
+	bucket, key, _ := strings.Cut(path, "/")
+	return bucket, key
+}
+
+func (s *s3Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := s3RequestBody(r)
+	if err != nil {
+		WriteXMLError(w, r, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+
+	bucketName, key := s3BucketAndKey(r)
+	query := r.URL.Query()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if bucketName == "" {
+		if r.Method == http.MethodGet {
+			s.listBuckets(w, r)
+		} else {
+			writeUnsupportedOperation(w, r, r.Method+" /")
+		}
+		return
+	}
+
+	if r.Method == http.MethodPut && key == "" && len(query) == 0 {
+		s.createBucket(w, r, bucketName)
+		return
+	}
+
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		WriteXMLError(w, r, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	if key != "" {
+		s.serveObject(w, r, bucket, key, body)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		w.WriteHeader(http.StatusOK)
+
+	case r.Method == http.MethodDelete && len(query) == 0:
+		if len(bucket.objects) > 0 {
+			WriteXMLError(w, r, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+			return
+		}
+		delete(s.buckets, bucketName)
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodGet && query.Has("location"):
+		location := bucket.region
+		if location == "us-east-1" { // lintignore:AWSAT003
+			location = ""
+		}
+		WriteXML(w, r, "LocationConstraint", location)
+
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		s.listObjectsV2(w, r, bucketName, bucket)
+
+	case r.Method == http.MethodGet && query.Has("versions"):
+		s.listObjectVersions(w, r, bucketName, bucket)
+
+	case r.Method == http.MethodPost && query.Has("delete"):
+		s.deleteObjects(w, r, bucket, body)
+
+	default:
+		s.serveSubresource(w, r, s3BucketSubresources, bucket.subresources, body)
+	}
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *http.Request, bucketName string) {
+	if _, ok := s.buckets[bucketName]; ok {
+		WriteXMLError(w, r, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+		return
+	}
+
+	bucket := &s3Bucket{
+		creationDate: time.Now().UTC().Truncate(time.Second),
+		objects:      make(map[string]*s3Object),
+		region:       Region(r),
+		subresources: make(map[string][]byte),
+	}
+	if strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true") {
+		bucket.subresources["object-lock"] = []byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+		bucket.subresources["versioning"] = []byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`)
+	}
+	if v := r.Header.Get("X-Amz-Object-Ownership"); v != "" {
+		bucket.subresources["ownershipControls"] = fmt.Appendf(nil, `<OwnershipControls><Rule><ObjectOwnership>%s</ObjectOwnership></Rule></OwnershipControls>`, v)
+	}
+	s.buckets[bucketName] = bucket
+
+	w.Header().Set("Location", "/"+bucketName)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter, r *http.Request) {
+	type bucketShape struct {
+		BucketRegion string
+		CreationDate string
+		Name         string
+	}
+	var buckets []bucketShape
+	for _, name := range slices.Sorted(maps.Keys(s.buckets)) {
+		buckets = append(buckets, bucketShape{
+			BucketRegion: s.buckets[name].region,
+			CreationDate: s.buckets[name].creationDate.Format(time.RFC3339),
+			Name:         name,
+		})
+	}
+
+	WriteXML(w, r, "ListAllMyBucketsResult", struct {
+		Buckets []bucketShape `xml:"Buckets>Bucket"`
+		Owner   struct {
+			ID string
+		}
+	}{
+		Buckets: buckets,
+		Owner:   struct{ ID string }{ID: s3CanonicalOwnerID},
+	})
+}
+
+type s3ObjectShape struct {
+	ETag         string
+	IsLatest     *bool `xml:",omitempty"`
+	Key          string
+	LastModified string
+	Size         int
+	StorageClass string
+	VersionID    string `xml:"VersionId,omitempty"`
+}
+
+func (s *s3Service) matchingObjects(r *http.Request, bucket *s3Bucket) []string {
+	prefix := r.URL.Query().Get("prefix")
+
+	var keys []string
+	for _, key := range slices.Sorted(maps.Keys(bucket.objects)) {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (s *s3Service) listObjectsV2(w http.ResponseWriter, r *http.Request, bucketName string, bucket *s3Bucket) {
+	keys := s.matchingObjects(r, bucket)
+
+	var contents []s3ObjectShape
+	for _, key := range keys {
+		object := bucket.objects[key]
+		contents = append(contents, s3ObjectShape{
+			ETag:         object.etag,
+			Key:          key,
+			LastModified: object.lastModified.Format(time.RFC3339),
+			Size:         len(object.body),
+			StorageClass: "STANDARD",
+		})
+	}
+
+	WriteXML(w, r, "ListBucketResult", struct {
+		Contents    []s3ObjectShape
+		IsTruncated bool
+		KeyCount    int
+		Name        string
+		Prefix      string
+	}{
+		Contents: contents,
+		KeyCount: len(contents),
+		Name:     bucketName,
+		Prefix:   r.URL.Query().Get("prefix"),
+	})
+}
+
+func (s *s3Service) listObjectVersions(w http.ResponseWriter, r *http.Request, bucketName string, bucket *s3Bucket) {
+	keys := s.matchingObjects(r, bucket)
+
+	isLatest := true
+	var versions []s3ObjectShape
+	for _, key := range keys {
+		object := bucket.objects[key]
+		versions = append(versions, s3ObjectShape{
+			ETag:         object.etag,
+			IsLatest:     &isLatest,
+			Key:          key,
+			LastModified: object.lastModified.Format(time.RFC3339),
+			Size:         len(object.body),
+			StorageClass: "STANDARD",
+			VersionID:    "null",
+		})
+	}
+
+	WriteXML(w, r, "ListVersionsResult", struct {
+		IsTruncated bool
+		Name        string
+		Prefix      string
+		Version     []s3ObjectShape
+	}{
+		Name:    bucketName,
+		Prefix:  r.URL.Query().Get("prefix"),
+		Version: versions,
+	})
+}
+
+func (s *s3Service) deleteObjects(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, body []byte) {
+	type objectIdentifier struct {
+		Key       string
+		VersionID string `xml:"VersionId,omitempty"`
+	}
+
+	var input struct {
+		Objects []objectIdentifier `xml:"Object"`
+	}
+	if err := xml.Unmarshal(body, &input); err != nil {
+		WriteXMLError(w, r, http.StatusBadRequest, "MalformedXML", err.Error())
+		return
+	}
+
+	for _, v := range input.Objects {
+		delete(bucket.objects, v.Key)
+	}
+
+	WriteXML(w, r, "DeleteResult", struct {
+		Deleted []objectIdentifier
+	}{
+		Deleted: input.Objects,
+	})
+}
+
+func (s *s3Service) serveObject(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string, body []byte) {
+	query := r.URL.Query()
+	object, ok := bucket.objects[key]
+
+	if r.Method == http.MethodPut && !s3HasSubresource(query, s3ObjectSubresources) {
+		object := &s3Object{
+			body:         body,
+			contentType:  r.Header.Get("Content-Type"),
+			etag:         fmt.Sprintf("%q", s3ETag(body)),
+			lastModified: time.Now().UTC().Truncate(time.Second),
+			metadata:     make(http.Header),
+			subresources: make(map[string][]byte),
+		}
+		if object.contentType == "" {
+			object.contentType = "binary/octet-stream"
+		}
+		for name, values := range r.Header {
+			if strings.HasPrefix(strings.ToLower(name), "x-amz-meta-") {
+				object.metadata[name] = values
+			}
+		}
+		bucket.objects[key] = object
+
+		w.Header().Set("ETag", object.etag)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if !ok {
+		if r.Method == http.MethodDelete && len(query) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		WriteXMLError(w, r, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodDelete && !s3HasSubresource(query, s3ObjectSubresources):
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	case (r.Method == http.MethodGet || r.Method == http.MethodHead) && !s3HasSubresource(query, s3ObjectSubresources):
+		for name, values := range object.metadata {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("ETag", object.etag)
+		w.Header().Set("Last-Modified", object.lastModified.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.body) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+		}
+
+	default:
+		s.serveSubresource(w, r, s3ObjectSubresources, object.subresources, body)
+	}
+}
+
+// serveSubresource implements GET, PUT and DELETE of a bucket or object subresource.
+// The request body of a PUT is stored and returned verbatim by subsequent GETs.
+func (s *s3Service) serveSubresource(w http.ResponseWriter, r *http.Request, known map[string]s3Subresource, stored map[string][]byte, body []byte) {
+	query := r.URL.Query()
+
+	var name string
+	for v := range query {
+		if _, ok := known[v]; ok {
+			name = v
+			break
+		}
+	}
+	if name == "" {
+		WriteXMLError(w, r, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("fake backend: %s %s?%s is not supported", r.Method, r.URL.Path, r.URL.RawQuery))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		delete(stored, name)
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodGet:
+		v, ok := stored[name]
+		if !ok {
+			if code := known[name].notFound; code != "" {
+				WriteXMLError(w, r, http.StatusNotFound, code, fmt.Sprintf("The %s configuration does not exist", name))
+				return
+			}
+			v = []byte(known[name].defaultBody)
+		}
+
+		if name == "policy" {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/xml")
+			v = s3WithNamespace(v)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(v) //nolint:errcheck // Writes to an httptest.ResponseRecorder cannot fail
+
+	case http.MethodPut:
+		if len(body) == 0 {
+			// e.g. a canned ACL set via the x-amz-acl header.
+			delete(stored, name)
+		} else {
+			stored[name] = body
+		}
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		writeUnsupportedOperation(w, r, r.Method+" ?"+name)
+	}
+}
+
+func s3HasSubresource(query map[string][]string, known map[string]s3Subresource) bool {
+	for v := range query {
+		if _, ok := known[v]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// s3WithNamespace adds the S3 XML namespace to a document's root element if it has none.
+func s3WithNamespace(b []byte) []byte {
+	b = bytes.TrimSpace(b)
+	if bytes.HasPrefix(b, []byte("<?xml")) {
+		if i := bytes.Index(b, []byte("?>")); i >= 0 {
+			b = bytes.TrimSpace(b[i+2:])
+		}
+	}
+
+	end := bytes.IndexAny(b, " />")
+	if end < 0 || bytes.Contains(b[:bytes.IndexByte(b, '>')+1], []byte("xmlns=")) {
+		return b
+	}
+
+	return slices.Concat(b[:end], []byte(` xmlns="`+s3Namespace+`"`), b[end:])
+}
+
+func s3ETag(body []byte) string {
+	sum := md5.Sum(body) // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-md5 -- ETag values are MD5 digests
+	return hex.EncodeToString(sum[:])
+}
+
+// s3RequestBody reads a request's body, decoding the aws-chunked content encoding
+// used by the AWS SDK when sending streaming payloads with trailing checksums.
+func s3RequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") && r.Header.Get("X-Amz-Decoded-Content-Length") == "" {
+		return b, nil
+	}
+
+	var body []byte
+	reader := bufio.NewReader(bytes.NewReader(b))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("decoding aws-chunked body: %w", err)
+		}
+
+		// <hex size>[;chunk-signature=<signature>]\r\n<data>\r\n.
+		size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("decoding aws-chunked body: %w", err)
+		}
+		if n == 0 {
+			// Any trailing headers, e.g. checksums, are ignored.
+			return body, nil
+		}
+
+		chunk := make([]byte, n)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, fmt.Errorf("decoding aws-chunked body: %w", err)
+		}
+		body = append(body, chunk...)
+
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, fmt.Errorf("decoding aws-chunked body: %w", err)
+		}
+	}
+}
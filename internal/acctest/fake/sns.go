@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const snsNamespace = "http://sns.amazonaws.com/doc/2010-03-31/"
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type snsService struct {
+	lock   sync.Mutex
+	topics map[string]*snsTopic
+}
+
+func newSNSService(*Backend) http.Handler {
+	return &snsService{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+type snsEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsTag struct {
+	Key   string
+	Value string
+}
+
+func (s *snsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	action, err := QueryAction(r)
+	if err != nil {
+		WriteQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch action {
+	case "CreateTopic":
+		name := r.Form.Get("Name")
+		topicARN := ARN(r, "sns", name)
+
+		// CreateTopic is idempotent.
+		if _, ok := s.topics[topicARN]; !ok {
+			attributes := QueryMap(r, "Attributes.entry", "key", "value")
+			attributes["Owner"] = AccountID(r)
+			attributes["TopicArn"] = topicARN
+			if _, ok := attributes["DisplayName"]; !ok {
+				attributes["DisplayName"] = ""
+			}
+
+			s.topics[topicARN] = &snsTopic{
+				attributes: attributes,
+				tags:       QueryMap(r, "Tags.member", "Key", "Value"),
+			}
+		}
+
+		WriteQueryResult(w, action, snsNamespace, struct {
+			TopicArn string
+		}{
+			TopicArn: topicARN,
+		})
+
+	case "DeleteTopic":
+		delete(s.topics, r.Form.Get("TopicArn"))
+		WriteQueryResult(w, action, snsNamespace, nil)
+
+	case "GetTopicAttributes":
+		topic, ok := s.findTopic(w, r.Form.Get("TopicArn"))
+		if !ok {
+			return
+		}
+
+		var entries []snsEntry
+		for _, key := range slices.Sorted(maps.Keys(topic.attributes)) {
+			entries = append(entries, snsEntry{Key: key, Value: topic.attributes[key]})
+		}
+
+		WriteQueryResult(w, action, snsNamespace, struct {
+			Attributes []snsEntry `xml:"Attributes>entry"`
+		}{
+			Attributes: entries,
+		})
+
+	case "ListTagsForResource":
+		topic, ok := s.findTopic(w, r.Form.Get("ResourceArn"))
+		if !ok {
+			return
+		}
+
+		var tags []snsTag
+		for _, key := range slices.Sorted(maps.Keys(topic.tags)) {
+			tags = append(tags, snsTag{Key: key, Value: topic.tags[key]})
+		}
+
+		WriteQueryResult(w, action, snsNamespace, struct {
+			Tags []snsTag `xml:"Tags>member"`
+		}{
+			Tags: tags,
+		})
+
+	case "ListTopics":
+		type topicShape struct {
+			TopicArn string
+		}
+		var topics []topicShape
+		for _, topicARN := range slices.Sorted(maps.Keys(s.topics)) {
+			if strings.HasPrefix(topicARN, ARN(r, "sns", "")) {
+				topics = append(topics, topicShape{TopicArn: topicARN})
+			}
+		}
+
+		WriteQueryResult(w, action, snsNamespace, struct {
+			Topics []topicShape `xml:"Topics>member"`
+		}{
+			Topics: topics,
+		})
+
+	case "SetTopicAttributes":
+		topic, ok := s.findTopic(w, r.Form.Get("TopicArn"))
+		if !ok {
+			return
+		}
+
+		topic.attributes[r.Form.Get("AttributeName")] = r.Form.Get("AttributeValue")
+		WriteQueryResult(w, action, snsNamespace, nil)
+
+	case "TagResource":
+		topic, ok := s.findTopic(w, r.Form.Get("ResourceArn"))
+		if !ok {
+			return
+		}
+
+		maps.Copy(topic.tags, QueryMap(r, "Tags.member", "Key", "Value"))
+		WriteQueryResult(w, action, snsNamespace, nil)
+
+	case "UntagResource":
+		topic, ok := s.findTopic(w, r.Form.Get("ResourceArn"))
+		if !ok {
+			return
+		}
+
+		for _, key := range QueryList(r, "TagKeys.member") {
+			delete(topic.tags, key)
+		}
+		WriteQueryResult(w, action, snsNamespace, nil)
+
+	default:
+		writeUnsupportedOperation(w, r, action)
+	}
+}
+
+func (s *snsService) findTopic(w http.ResponseWriter, topicARN string) (*snsTopic, bool) {
+	topic, ok := s.topics[topicARN]
+	if !ok {
+		WriteQueryError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("Topic does not exist: %s", topicARN))
+	}
+
+	return topic, ok
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type sqsQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type sqsService struct {
+	lock   sync.Mutex
+	queues map[string]*sqsQueue // Keyed by queue URL.
+}
+
+func newSQSService(*Backend) http.Handler {
+	return &sqsService{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func sqsQueueURL(r *http.Request, name string) string {
+	return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", Region(r), AccountID(r), name)
+}
+
+func (s *sqsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		AttributeNames  []string
+		Attributes      map[string]string
+		QueueName       string
+		QueueNamePrefix string
+		QueueURL        string `json:"QueueUrl"`
+		TagKeys         []string
+		Tags            map[string]string
+	}
+	if err := ReadJSON(r, &input); err != nil {
+		WriteJSONError(w, r, http.StatusBadRequest, "InvalidParameterValue", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch op := JSONOperation(r); op {
+	case "CreateQueue":
+		queueURL := sqsQueueURL(r, input.QueueName)
+		if _, ok := s.queues[queueURL]; ok {
+			WriteJSON(w, r, map[string]string{"QueueUrl": queueURL})
+			return
+		}
+
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		attributes := map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      now,
+			"DelaySeconds":                          "0",
+			"LastModifiedTimestamp":                 now,
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              ARN(r, "sqs", input.QueueName),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"SqsManagedSseEnabled":                  "true",
+			"VisibilityTimeout":                     "30",
+		}
+		maps.Copy(attributes, input.Attributes)
+
+		tags := input.Tags
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+
+		s.queues[queueURL] = &sqsQueue{
+			attributes: attributes,
+			tags:       tags,
+		}
+
+		WriteJSON(w, r, map[string]string{"QueueUrl": queueURL})
+
+	case "DeleteQueue":
+		if _, ok := s.findQueue(w, r, input.QueueURL); !ok {
+			return
+		}
+
+		delete(s.queues, input.QueueURL)
+		WriteJSON(w, r, struct{}{})
+
+	case "GetQueueAttributes":
+		queue, ok := s.findQueue(w, r, input.QueueURL)
+		if !ok {
+			return
+		}
+
+		attributes := make(map[string]string)
+		for key, value := range queue.attributes {
+			if slices.Contains(input.AttributeNames, "All") || slices.Contains(input.AttributeNames, key) {
+				attributes[key] = value
+			}
+		}
+
+		WriteJSON(w, r, map[string]any{"Attributes": attributes})
+
+	case "GetQueueUrl":
+		queueURL := sqsQueueURL(r, input.QueueName)
+		if _, ok := s.findQueue(w, r, queueURL); !ok {
+			return
+		}
+
+		WriteJSON(w, r, map[string]string{"QueueUrl": queueURL})
+
+	case "ListQueues":
+		prefix := sqsQueueURL(r, input.QueueNamePrefix)
+		var queueURLs []string
+		for _, queueURL := range slices.Sorted(maps.Keys(s.queues)) {
+			if strings.HasPrefix(queueURL, prefix) {
+				queueURLs = append(queueURLs, queueURL)
+			}
+		}
+
+		WriteJSON(w, r, map[string]any{"QueueUrls": queueURLs})
+
+	case "ListQueueTags":
+		queue, ok := s.findQueue(w, r, input.QueueURL)
+		if !ok {
+			return
+		}
+
+		WriteJSON(w, r, map[string]any{"Tags": queue.tags})
+
+	case "SetQueueAttributes":
+		queue, ok := s.findQueue(w, r, input.QueueURL)
+		if !ok {
+			return
+		}
+
+		maps.Copy(queue.attributes, input.Attributes)
+		queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+		WriteJSON(w, r, struct{}{})
+
+	case "TagQueue":
+		queue, ok := s.findQueue(w, r, input.QueueURL)
+		if !ok {
+			return
+		}
+
+		maps.Copy(queue.tags, input.Tags)
+		WriteJSON(w, r, struct{}{})
+
+	case "UntagQueue":
+		queue, ok := s.findQueue(w, r, input.QueueURL)
+		if !ok {
+			return
+		}
+
+		for _, key := range input.TagKeys {
+			delete(queue.tags, key)
+		}
+		WriteJSON(w, r, struct{}{})
+
+	default:
+		writeUnsupportedOperation(w, r, op)
+	}
+}
+
+func (s *sqsService) findQueue(w http.ResponseWriter, r *http.Request, queueURL string) (*sqsQueue, bool) {
+	queue, ok := s.queues[queueURL]
+	if !ok {
+		// SQS's JSON protocol reports the legacy AWS Query error code in a header.
+		w.Header().Set("X-Amzn-Query-Error", "AWS.SimpleQueueService.NonExistentQueue;Sender")
+		WriteJSONError(w, r, http.StatusBadRequest, "com.amazonaws.sqs#QueueDoesNotExist", "The specified queue does not exist.")
+	}
+
+	return queue, ok
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type ssmParameter struct {
+	allowedPattern   string
+	arn              string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             map[string]string
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+type ssmService struct {
+	lock       sync.Mutex
+	parameters map[string]*ssmParameter
+}
+
+func newSSMService(*Backend) http.Handler {
+	return &ssmService{
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+type ssmTag struct {
+	Key   string
+	Value string
+}
+
+type ssmParameterFilter struct {
+	Key    string
+	Option string
+	Values []string
+}
+
+func ssmTags(tags map[string]string) []ssmTag {
+	v := []ssmTag{}
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		v = append(v, ssmTag{Key: key, Value: tags[key]})
+	}
+
+	return v
+}
+
+func (p *ssmParameter) shape() map[string]any {
+	return map[string]any{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": float64(p.lastModifiedDate.UnixMilli()) / 1000,
+		"Name":             p.name,
+		"Type":             p.typ,
+		"Value":            p.value,
+		"Version":          p.version,
+	}
+}
+
+func (p *ssmParameter) metadataShape() map[string]any {
+	v := map[string]any{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": float64(p.lastModifiedDate.UnixMilli()) / 1000,
+		"Name":             p.name,
+		"Policies":         []any{},
+		"Tier":             p.tier,
+		"Type":             p.typ,
+		"Version":          p.version,
+	}
+	if p.allowedPattern != "" {
+		v["AllowedPattern"] = p.allowedPattern
+	}
+	if p.description != "" {
+		v["Description"] = p.description
+	}
+	if p.keyID != "" {
+		v["KeyId"] = p.keyID
+	}
+
+	return v
+}
+
+func (s *ssmService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		AllowedPattern   string
+		DataType         string
+		Description      string
+		KeyID            string `json:"KeyId"`
+		Name             string
+		Names            []string
+		Overwrite        bool
+		ParameterFilters []ssmParameterFilter
+		ResourceID       string `json:"ResourceId"`
+		ResourceType     string
+		TagKeys          []string
+		Tags             []ssmTag
+		Tier             string
+		Type             string
+		Value            string
+	}
+	if err := ReadJSON(r, &input); err != nil {
+		WriteJSONError(w, r, http.StatusBadRequest, "ValidationException", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch op := JSONOperation(r); op {
+	case "AddTagsToResource":
+		parameter, ok := s.findParameter(w, r, input.ResourceID)
+		if !ok {
+			return
+		}
+
+		for _, tag := range input.Tags {
+			parameter.tags[tag.Key] = tag.Value
+		}
+		WriteJSON(w, r, struct{}{})
+
+	case "DeleteParameter":
+		if _, ok := s.findParameter(w, r, input.Name); !ok {
+			return
+		}
+
+		delete(s.parameters, input.Name)
+		WriteJSON(w, r, struct{}{})
+
+	case "DescribeParameters":
+		parameters := []any{}
+		for _, name := range slices.Sorted(maps.Keys(s.parameters)) {
+			if ssmParameterMatchesFilters(name, input.ParameterFilters) {
+				parameters = append(parameters, s.parameters[name].metadataShape())
+			}
+		}
+
+		WriteJSON(w, r, map[string]any{"Parameters": parameters})
+
+	case "GetParameter":
+		parameter, ok := s.findParameter(w, r, input.Name)
+		if !ok {
+			return
+		}
+
+		WriteJSON(w, r, map[string]any{"Parameter": parameter.shape()})
+
+	case "GetParameters":
+		parameters := []any{}
+		invalidParameters := []string{}
+		for _, name := range input.Names {
+			if parameter, ok := s.parameters[name]; ok {
+				parameters = append(parameters, parameter.shape())
+			} else {
+				invalidParameters = append(invalidParameters, name)
+			}
+		}
+
+		WriteJSON(w, r, map[string]any{
+			"InvalidParameters": invalidParameters,
+			"Parameters":        parameters,
+		})
+
+	case "ListTagsForResource":
+		parameter, ok := s.findParameter(w, r, input.ResourceID)
+		if !ok {
+			return
+		}
+
+		WriteJSON(w, r, map[string]any{"TagList": ssmTags(parameter.tags)})
+
+	case "PutParameter":
+		parameter, ok := s.parameters[input.Name]
+		switch {
+		case ok && !input.Overwrite:
+			WriteJSONError(w, r, http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+			return
+		case ok && len(input.Tags) > 0:
+			WriteJSONError(w, r, http.StatusBadRequest, "ValidationException", "Invalid request: tags and overwrite can't be used together.")
+			return
+		case !ok:
+			resource := "parameter/" + strings.TrimPrefix(input.Name, "/")
+			parameter = &ssmParameter{
+				arn:      ARN(r, "ssm", resource),
+				dataType: "text",
+				name:     input.Name,
+				tags:     make(map[string]string),
+				tier:     "Standard",
+				typ:      "String",
+			}
+			for _, tag := range input.Tags {
+				parameter.tags[tag.Key] = tag.Value
+			}
+			s.parameters[input.Name] = parameter
+		}
+
+		if input.AllowedPattern != "" {
+			parameter.allowedPattern = input.AllowedPattern
+		}
+		if input.DataType != "" {
+			parameter.dataType = input.DataType
+		}
+		if input.Description != "" {
+			parameter.description = input.Description
+		}
+		if input.Tier != "" && input.Tier != "Intelligent-Tiering" {
+			parameter.tier = input.Tier
+		}
+		if input.Type != "" {
+			parameter.typ = input.Type
+		}
+		switch {
+		case input.KeyID != "":
+			parameter.keyID = input.KeyID
+		case parameter.typ == "SecureString" && parameter.keyID == "":
+			parameter.keyID = "alias/aws/ssm"
+		}
+		parameter.lastModifiedDate = time.Now()
+		parameter.value = input.Value
+		parameter.version++
+
+		WriteJSON(w, r, map[string]any{
+			"Tier":    parameter.tier,
+			"Version": parameter.version,
+		})
+
+	case "RemoveTagsFromResource":
+		parameter, ok := s.findParameter(w, r, input.ResourceID)
+		if !ok {
+			return
+		}
+
+		for _, key := range input.TagKeys {
+			delete(parameter.tags, key)
+		}
+		WriteJSON(w, r, struct{}{})
+
+	default:
+		writeUnsupportedOperation(w, r, op)
+	}
+}
+
+func (s *ssmService) findParameter(w http.ResponseWriter, r *http.Request, name string) (*ssmParameter, bool) {
+	parameter, ok := s.parameters[name]
+	if !ok {
+		WriteJSONError(w, r, http.StatusBadRequest, "ParameterNotFound", fmt.Sprintf("Parameter %s not found.", name))
+	}
+
+	return parameter, ok
+}
+
+// ssmParameterMatchesFilters implements the "Name" and "Path" DescribeParameters filters.
+// Other filters are ignored.
+func ssmParameterMatchesFilters(name string, filters []ssmParameterFilter) bool {
+	for _, filter := range filters {
+		switch filter.Key {
+		case "Name":
+			match := slices.ContainsFunc(filter.Values, func(v string) bool {
+				if filter.Option == "BeginsWith" {
+					return strings.HasPrefix(name, v)
+				}
+				return name == v
+			})
+			if !match {
+				return false
+			}
+		case "Path":
+			match := slices.ContainsFunc(filter.Values, func(v string) bool {
+				prefix := strings.TrimSuffix(v, "/") + "/"
+				if filter.Option == "Recursive" {
+					return strings.HasPrefix(name, prefix)
+				}
+				return strings.HasPrefix(name, prefix) && !strings.Contains(strings.TrimPrefix(name, prefix), "/")
+			})
+			if !match {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"net/http"
+)
+
+const stsNamespace = "https://sts.amazonaws.com/doc/2011-06-15/"
+
+type stsService struct{}
+
+func newSTSService(*Backend) http.Handler {
+	return &stsService{}
+}
+
+func (s *stsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	action, err := QueryAction(r)
+	if err != nil {
+		WriteQueryError(w, http.StatusBadRequest, "MalformedInput", err.Error())
+		return
+	}
+
+	switch action {
+	case "GetCallerIdentity":
+		WriteQueryResult(w, action, stsNamespace, struct {
+			Account string
+			Arn     string
+			UserID  string `xml:"UserId"`
+		}{
+			Account: AccountID(r),
+			Arn:     GlobalARN(r, "iam", "user/fake"),
+			UserID:  "AIDAFAKEFAKEFAKEFAKE",
+		})
+	default:
+		writeUnsupportedOperation(w, r, action)
+	}
+}
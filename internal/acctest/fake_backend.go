@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// fakeBackend is the in-process fake AWS backend shared by all tests in a test binary.
+// Sharing a single backend lets CheckDestroy and other check functions, which use the
+// global Provider, see the resources created by each test's provider instances.
+var fakeBackend = sync.OnceValue(fake.New)
+
+var fakeBackendEnvironment sync.Once
+
+func isFakeBackendEnabled() bool {
+	return os.Getenv(envvar.AccFakeBackend) != ""
+}
+
+// FakeBackend returns the in-process fake AWS backend used when TF_ACC_FAKE_BACKEND is set.
+func FakeBackend() *fake.Backend {
+	return fakeBackend()
+}
+
+// configureFakeBackendEnvironment sets static placeholder credentials so that no real
+// credentials are resolved or required when all requests are handled by the fake backend.
+func configureFakeBackendEnvironment() {
+	fakeBackendEnvironment.Do(func() {
+		os.Setenv(envvar.AccessKeyId, "AKIAFAKEBACKEND")
+		os.Setenv(envvar.SecretAccessKey, "fake-backend-secret-access-key")
+		os.Unsetenv("AWS_SESSION_TOKEN")
+	})
+}
+
+// fakeBackendProviderMeta sets the fake backend's HTTP client on a provider's meta.
+// As the HTTP client is used in the provider's ConfigureContextFunc this must be done before calling the ConfigureContextFunc.
+func fakeBackendProviderMeta(ctx context.Context, p *schema.Provider) {
+	configureFakeBackendEnvironment()
+
+	meta, ok := p.Meta().(*conns.AWSClient)
+	if !ok {
+		meta = new(conns.AWSClient)
+	}
+	meta.SetHTTPClient(ctx, fakeBackend().HTTPClient())
+	p.SetMeta(meta)
+}
+
+// fakeBackendProtoV5ProviderFactories returns ProtoV5ProviderFactories whose providers send all AWS API requests to the fake backend.
+func fakeBackendProtoV5ProviderFactories(ctx context.Context, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+
+	for name := range input {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			configureContextFunc := primary.ConfigureContextFunc
+			primary.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+				fakeBackendProviderMeta(ctx, primary)
+
+				return configureContextFunc(ctx, d)
+			}
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
@@ -427,11 +427,14 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// ParallelTest wraps resource.ParallelTest, initializing VCR or the fake AWS backend if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	switch {
+	case isFakeBackendEnabled():
+		c.ProtoV5ProviderFactories = fakeBackendProtoV5ProviderFactories(ctx, c.ProtoV5ProviderFactories)
+	case isVCREnabled():
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -439,11 +442,14 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled.
+// Test wraps resource.Test, initializing VCR or the fake AWS backend if enabled.
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	switch {
+	case isFakeBackendEnabled():
+		c.ProtoV5ProviderFactories = fakeBackendProtoV5ProviderFactories(ctx, c.ProtoV5ProviderFactories)
+	case isVCREnabled():
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// AuditLogPathEnvVar is the environment variable that sets the API call audit log path
+	// when `audit_log_path` is not set in the provider configuration.
+	AuditLogPathEnvVar = "TF_AWS_AUDIT_LOG_PATH"
+)
+
+// auditLogRecord is a single line in the API call audit log.
+type auditLogRecord struct {
+	Time              time.Time `json:"time"`
+	Service           string    `json:"service"`
+	Operation         string    `json:"operation"`
+	Region            string    `json:"region"`
+	AccountID         string    `json:"account_id"`
+	ResourceType      string    `json:"resource_type,omitempty"`
+	ResourceOperation string    `json:"resource_operation,omitempty"`
+	Mutating          bool      `json:"mutating"`
+	DurationMS        int64     `json:"duration_ms"`
+	RetryCount        int       `json:"retry_count"`
+	Success           bool      `json:"success"`
+	ErrorCode         string    `json:"error_code,omitempty"`
+	RequestID         string    `json:"request_id,omitempty"`
+}
+
+// auditLog writes API call audit records as JSON lines to a file.
+// Records are written whole so that concurrent API calls don't interleave lines.
+// The file is opened for each record and closed once the record is written, so no file handle outlives an API call
+// and each record is on disk by the time the call returns.
+type auditLog struct {
+	lock sync.Mutex
+	path string
+}
+
+func newAuditLog(path string) *auditLog {
+	return &auditLog{path: path}
+}
+
+func (l *auditLog) open() (*os.File, error) {
+	return os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+func (l *auditLog) write(record auditLogRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	f, err := l.open()
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+var auditLogs = struct {
+	lock sync.Mutex
+	logs map[string]*auditLog
+}{
+	logs: make(map[string]*auditLog),
+}
+
+// openAuditLog returns the audit log that appends to the file at the specified path, creating the file if necessary.
+// All provider instances, e.g. aliased providers, that are configured with the same path share an audit log.
+func openAuditLog(path string) (*auditLog, error) {
+	auditLogs.lock.Lock()
+	defer auditLogs.lock.Unlock()
+
+	if v, ok := auditLogs.logs[path]; ok {
+		return v, nil
+	}
+
+	v := newAuditLog(path)
+
+	// Report an unwritable path when the provider is configured rather than on the first API call.
+	f, err := v.open()
+	if err != nil {
+		return nil, fmt.Errorf("opening API call audit log (%s): %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("closing API call audit log (%s): %w", path, err)
+	}
+
+	auditLogs.logs[path] = v
+
+	return v, nil
+}
+
+// auditLogMiddleware returns an AWS SDK for Go v2 API option that writes a record of each API call to the specified audit log.
+// The middleware is added at the end of the Initialize step, after the operation name and Region have been registered
+// and before the Finalize step's retry loop, so that the recorded duration includes all retry attempts.
+func auditLogMiddleware(servicePackageName, accountID string, log *auditLog) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			operation := awsmiddleware.GetOperationName(ctx)
+			record := auditLogRecord{
+				Time:       start.UTC(),
+				Service:    servicePackageName,
+				Operation:  operation,
+				Region:     awsmiddleware.GetRegion(ctx),
+				AccountID:  accountID,
+				Mutating:   IsMutatingOperation(operation),
+				DurationMS: time.Since(start).Milliseconds(),
+				Success:    err == nil,
+			}
+			if v, ok := FromContext(ctx); ok {
+				record.ResourceType = v.TypeName()
+				record.ResourceOperation = v.ResourceOperation()
+			}
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				record.RetryCount = len(v.Results) - 1
+			}
+			if apiErr, ok := errs.As[smithy.APIError](err); ok {
+				record.ErrorCode = apiErr.ErrorCode()
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				record.RequestID = v
+			}
+
+			if err := log.write(record); err != nil {
+				tflog.Warn(ctx, "writing API call audit log", map[string]any{
+					"error": err.Error(),
+				})
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
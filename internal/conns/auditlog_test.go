@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAuditLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		Context   func(context.Context) context.Context
+		Operation string
+		Err       error
+		Expected  auditLogRecord
+	}{
+		{
+			Name:      "no resource",
+			Context:   func(ctx context.Context) context.Context { return ctx },
+			Operation: "ListBuckets",
+			Expected: auditLogRecord{
+				Service:   "test",
+				Operation: "ListBuckets",
+				Region:    "us-west-2", //lintignore:AWSAT003
+				AccountID: "123456789012",
+				Success:   true,
+			},
+		},
+		{
+			Name: "resource create",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "test", "Bucket", "aws_test_bucket")
+				return WithResourceOperation(ctx, "Create")
+			},
+			Operation: "CreateBucket",
+			Expected: auditLogRecord{
+				Service:           "test",
+				Operation:         "CreateBucket",
+				Region:            "us-west-2", //lintignore:AWSAT003
+				AccountID:         "123456789012",
+				ResourceType:      "aws_test_bucket",
+				ResourceOperation: "Create",
+				Mutating:          true,
+				Success:           true,
+			},
+		},
+		{
+			Name: "error",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "test", "Bucket", "aws_test_bucket")
+				return WithResourceOperation(ctx, "Delete")
+			},
+			Operation: "DeleteBucket",
+			Err:       &smithy.GenericAPIError{Code: "AccessDenied"},
+			Expected: auditLogRecord{
+				Service:           "test",
+				Operation:         "DeleteBucket",
+				Region:            "us-west-2", //lintignore:AWSAT003
+				AccountID:         "123456789012",
+				ResourceType:      "aws_test_bucket",
+				ResourceOperation: "Delete",
+				Mutating:          true,
+				ErrorCode:         "AccessDenied",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "audit.log")
+			log := newAuditLog(path)
+
+			stack := middleware.NewStack(testCase.Operation, func() any { return struct{}{} })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				OperationName: testCase.Operation,
+				Region:        "us-west-2", //lintignore:AWSAT003
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := auditLogMiddleware("test", "123456789012", log)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+				return struct{}{}, middleware.Metadata{}, testCase.Err
+			}), stack)
+
+			ctx := testCase.Context(context.Background())
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != testCase.Err { //nolint:errorlint // Error must be passed through unchanged
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			b, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := bytes.Split(bytes.TrimSuffix(b, []byte("\n")), []byte("\n"))
+			if got, want := len(lines), 1; got != want {
+				t.Fatalf("got %d audit log lines, expected %d", got, want)
+			}
+
+			var got auditLogRecord
+			if err := json.Unmarshal(lines[0], &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Time.IsZero() {
+				t.Error("expected time to be set")
+			}
+			if diff := cmp.Diff(got, testCase.Expected, cmpopts.IgnoreFields(auditLogRecord{}, "Time", "DurationMS")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOpenAuditLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log1, err := openAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log2, err := openAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log1 != log2 {
+		t.Error("expected provider instances configured with the same path to share an audit log")
+	}
+
+	for _, operation := range []string{"CreateBucket", "DeleteBucket"} {
+		if err := log1.write(auditLogRecord{Operation: operation}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Records are on disk as soon as they are written.
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bytes.Count(b, []byte("\n")), 2; got != want {
+		t.Errorf("got %d audit log lines, expected %d", got, want)
+	}
+
+	if _, err := openAuditLog(filepath.Join(t.TempDir(), "missing", "audit.log")); err == nil {
+		t.Error("expected error opening audit log in a missing directory")
+	}
+}
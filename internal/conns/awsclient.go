@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -18,17 +19,20 @@ import (
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
 	accountID                 string
+	auditLog                  *auditLog // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
@@ -38,13 +42,19 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	readOnly                  bool                // From provider configuration.
+	readOnlyAllowed           map[string][]string // From provider configuration.
 	region                    string
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	semaphores                map[string]tfsync.Semaphore
+	semaphoresLock            sync.Mutex
+	serviceConcurrency        map[string]int // From provider configuration.
+	stsRegion                 string         // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -79,8 +89,16 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	if region := c.Region(ctx); region != c.region {
+		cfg.Region = region
+	}
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -103,7 +121,14 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If the resource's Region has been overridden by its `region` argument, that Region is returned.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok {
+		if region := v.OverrideRegion(); region != "" {
+			return region
+		}
+	}
+
 	return c.region
 }
 
@@ -168,18 +193,24 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	if s3Client.Options().Region != endpoints.AwsGlobalRegionID {
+		return s3Client
+	}
+
+	// No global endpoint for S3 Express.
+	if c.Region(ctx) != c.region {
+		return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			"s3_us_east_1_regional_endpoint": "regional",
+		}))
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
 	if c.s3ExpressClient == nil {
-		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
-			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
-				"s3_us_east_1_regional_endpoint": "regional",
-			}))
-		} else {
-			c.s3ExpressClient = s3Client
-		}
+		c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			"s3_us_east_1_regional_endpoint": "regional",
+		}))
 	}
 
 	return c.s3ExpressClient
@@ -334,6 +365,26 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	var apiOptions []func(*middleware.Stack) error
+	if c.auditLog != nil {
+		apiOptions = append(apiOptions, auditLogMiddleware(servicePackageName, c.accountID, c.auditLog))
+	}
+	if c.readOnly {
+		if operations, ok := c.readOnlyAllowed[servicePackageName]; !ok || len(operations) > 0 {
+			apiOptions = append(apiOptions, readOnlyMiddleware(servicePackageName, operations))
+		}
+	}
+	if limit := c.concurrencyLimit(ctx, servicePackageName); limit > 0 {
+		apiOptions = append(apiOptions, concurrencyLimitMiddleware(servicePackageName, c.semaphore(servicePackageName, limit)))
+	}
+	if region := c.Region(ctx); len(apiOptions) > 0 || region != c.region {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOptions...)
+		// Clients for a resource's overridden Region are built from the shared configuration.
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
+	}
+
 	return m
 }
 
@@ -344,12 +395,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := clientCacheKey(ctx, c, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -384,8 +436,18 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// clientCacheKey returns the key under which the default service client is cached.
+// Clients for a resource's overridden Region are cached separately from those for the configured Region.
+func clientCacheKey(ctx context.Context, c *AWSClient, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "/" + region
+	}
+
+	return servicePackageName
+}
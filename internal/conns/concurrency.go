@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// ServicePackageWithConcurrencyLimit is an interface that extends ServicePackage with a default
+// limit on the number of in-flight mutating AWS API calls.
+// The limit can be overridden using the provider's `service_concurrency` configuration.
+type ServicePackageWithConcurrencyLimit interface {
+	ServicePackage
+	ConcurrencyLimit(context.Context) int
+}
+
+// concurrencyLimit returns the maximum number of in-flight mutating AWS API calls for the specified service.
+// Zero means that calls are not limited.
+func (c *AWSClient) concurrencyLimit(ctx context.Context, servicePackageName string) int {
+	if v, ok := c.serviceConcurrency[servicePackageName]; ok {
+		return v
+	}
+
+	if v, ok := c.ServicePackage(ctx, servicePackageName).(ServicePackageWithConcurrencyLimit); ok {
+		return v.ConcurrencyLimit(ctx)
+	}
+
+	return 0
+}
+
+// semaphore returns the semaphore used to limit in-flight mutating AWS API calls for the specified service.
+// All API clients for a service share the same semaphore.
+func (c *AWSClient) semaphore(servicePackageName string, limit int) tfsync.Semaphore {
+	c.semaphoresLock.Lock()
+	defer c.semaphoresLock.Unlock()
+
+	semaphore, ok := c.semaphores[servicePackageName]
+	if !ok {
+		if c.semaphores == nil {
+			c.semaphores = make(map[string]tfsync.Semaphore)
+		}
+		semaphore = tfsync.NewSemaphore(limit)
+		c.semaphores[servicePackageName] = semaphore
+	}
+
+	return semaphore
+}
+
+// concurrencyLimitMiddleware returns an AWS SDK for Go v2 API option that limits the number of in-flight
+// mutating API calls using the specified semaphore.
+// The middleware runs after the retry middleware so that a slot is held only for the duration of
+// a single attempt and not while waiting to retry.
+func concurrencyLimitMiddleware(servicePackageName string, semaphore tfsync.Semaphore) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TerraformConcurrencyLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if !IsMutatingOperation(operation) {
+				return next.HandleFinalize(ctx, in)
+			}
+
+			if err := semaphore.WaitContext(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer semaphore.Notify()
+
+			tflog.Trace(ctx, "acquired concurrency limit slot", map[string]any{
+				"tf_aws.service_package": servicePackageName,
+				"tf_aws.operation":       operation,
+			})
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	}
+}
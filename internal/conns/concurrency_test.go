@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type concurrencyLimitServicePackage struct {
+	ServicePackage
+	limit int
+}
+
+func (sp concurrencyLimitServicePackage) ConcurrencyLimit(context.Context) int {
+	return sp.limit
+}
+
+func TestAWSClientConcurrencyLimit(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Expected  int
+	}{
+		{
+			Name:      "no limit",
+			AWSClient: &AWSClient{},
+			Expected:  0,
+		},
+		{
+			Name: "service package default",
+			AWSClient: &AWSClient{
+				servicePackages: map[string]ServicePackage{
+					"test": concurrencyLimitServicePackage{limit: 5},
+				},
+			},
+			Expected: 5,
+		},
+		{
+			Name: "provider configuration",
+			AWSClient: &AWSClient{
+				serviceConcurrency: map[string]int{
+					"test": 2,
+				},
+				servicePackages: map[string]ServicePackage{
+					"test": concurrencyLimitServicePackage{limit: 5},
+				},
+			},
+			Expected: 2,
+		},
+		{
+			Name: "provider configuration removes default",
+			AWSClient: &AWSClient{
+				serviceConcurrency: map[string]int{
+					"test": 0,
+				},
+				servicePackages: map[string]ServicePackage{
+					"test": concurrencyLimitServicePackage{limit: 5},
+				},
+			},
+			Expected: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.AWSClient.concurrencyLimit(ctx, "test"), testCase.Expected; got != want {
+				t.Errorf("got %d, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limit = 2
+		calls = 10
+	)
+
+	c := &AWSClient{}
+	semaphore := c.semaphore("test", limit)
+	if got, want := c.semaphore("test", limit+1), semaphore; got != want {
+		t.Fatal("expected semaphore to be shared")
+	}
+
+	testCases := []struct {
+		Name        string
+		Operation   string
+		ExpectLimit bool
+	}{
+		{
+			Name:        "mutating",
+			Operation:   "CreateBucket",
+			ExpectLimit: true,
+		},
+		{
+			Name:      "read-only",
+			Operation: "ListBuckets",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			stack := middleware.NewStack(testCase.Operation, func() any { return struct{}{} })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.Operation}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := concurrencyLimitMiddleware("test", semaphore)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var inFlight, maxInFlight atomic.Int32
+			release := make(chan struct{})
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+				n := inFlight.Add(1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				<-release
+				inFlight.Add(-1)
+				return struct{}{}, middleware.Metadata{}, nil
+			}), stack)
+
+			ctx := context.Background()
+
+			var wg sync.WaitGroup
+			for range calls {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			for range calls {
+				release <- struct{}{}
+			}
+			wg.Wait()
+
+			if got, want := maxInFlight.Load() <= limit, testCase.ExpectLimit; want && !got {
+				t.Errorf("max in-flight calls %d exceeds limit %d", maxInFlight.Load(), limit)
+			}
+			if got := len(semaphore); got != 0 {
+				t.Errorf("%d semaphore slots still held", got)
+			}
+		})
+	}
+}
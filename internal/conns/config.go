@@ -6,6 +6,7 @@ package conns
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -31,6 +32,9 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
+	CredentialProcess              string
+	CredentialsRefreshWindow       time.Duration
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -45,17 +49,21 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnly                       bool
+	ReadOnlyAllowed                map[string][]string
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceConcurrency             map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
+	TagPolicyConfig                *tftags.PolicyConfig
 	SuppressDebugLog               bool
 	TerraformVersion               string
 	Token                          string
@@ -136,6 +144,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// Credentials sourced from an external process are passed to aws-sdk-go-base as their initial static values
+	// and the caching process credentials provider then replaces the static credentials provider.
+	var processCredentials aws.CredentialsProvider
+	if c.CredentialProcess != "" {
+		processCredentials = newProcessCredentialsProvider(c.CredentialProcess)
+
+		tflog.Debug(ctx, "Retrieving credentials from external process")
+		creds, err := processCredentials.Retrieve(ctx)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving credentials from credential_process: %s", err)
+		}
+
+		awsbaseConfig.AccessKey = creds.AccessKeyID
+		awsbaseConfig.SecretKey = creds.SecretAccessKey
+		awsbaseConfig.Token = creds.SessionToken
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -156,6 +181,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if processCredentials != nil {
+		cfg.Credentials = processCredentials
+	}
+	// All AWS API clients share the one credentials cache, refreshing credentials before they expire.
+	cfg.Credentials = newRefreshingCredentialsProvider(cfg.Credentials, c.CredentialsRefreshWindow)
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -207,6 +238,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	auditLogPath := c.AuditLogPath
+	if auditLogPath == "" {
+		auditLogPath = os.Getenv(AuditLogPathEnvVar)
+	}
+	if auditLogPath != "" {
+		auditLog, err := openAuditLog(auditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.auditLog = auditLog
+	}
+
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
@@ -219,9 +262,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.readOnly = c.ReadOnly
+	client.readOnlyAllowed = c.ReadOnlyAllowed
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceConcurrency = c.ServiceConcurrency
 	client.stsRegion = c.STSRegion
+	client.tagPolicyConfig = c.TagPolicyConfig
 
 	return client, diags
 }
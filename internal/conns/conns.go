@@ -26,6 +26,14 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithResourceListers is an interface that extends ServicePackage with resource listers.
+// Resource listers enumerate the existing instances of a resource type in the configured account and Region,
+// returning the IDs accepted by the resource's importer.
+type ServicePackageWithResourceListers interface {
+	ServicePackage
+	ResourceListers(context.Context) []*types.ServicePackageResourceLister
+}
+
 type (
 	contextKeyType int
 )
@@ -38,8 +46,11 @@ var (
 type InContext struct {
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
+	overrideRegion      string // Region from the resource's `region` argument, if any
 	resourceName        string // Friendly resource name, e.g. "Subnet"
+	resourceOperation   string // CRUD operation being run, e.g. "Create"
 	servicePackageName  string // Canonical name defined as a constant in names package
+	typeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
 // IsDataSource returns true if the resource is a data source.
@@ -52,40 +63,60 @@ func (c *InContext) IsEphemeralResource() bool {
 	return c.isEphemeralResource
 }
 
+// OverrideRegion returns the Region from the resource's `region` argument.
+// An empty string is returned if no Region override has been set.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
 }
 
+// ResourceOperation returns the CRUD operation being run, e.g. "Create".
+// An empty string is returned if no operation has been set.
+func (c *InContext) ResourceOperation() string {
+	return c.resourceOperation
+}
+
 // ServicePackageName returns the canonical service name defined as a constant in the `names` package.
 func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isDataSource:       true,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
+		typeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -95,3 +126,31 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+// WithResourceOperation returns a copy of the Context with the CRUD operation being run set.
+// The Context is returned unchanged if it has no resource information.
+func WithResourceOperation(ctx context.Context, operation string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.resourceOperation = operation
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
+// WithOverrideRegion returns a copy of the Context with the resource's Region override set.
+// The Context is returned unchanged if it has no resource information.
+func WithOverrideRegion(ctx context.Context, region string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.overrideRegion = region
+
+	return context.WithValue(ctx, contextKey, &w)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultCredentialsRefreshWindow is the default period before expiry in which credentials are refreshed.
+	DefaultCredentialsRefreshWindow = 5 * time.Minute
+
+	credentialsRefreshRetryInterval = 1 * time.Minute
+)
+
+// newProcessCredentialsProvider returns a caching credentials provider that sources credentials from an external process.
+func newProcessCredentialsProvider(command string) aws.CredentialsProvider {
+	return aws.NewCredentialsCache(processcreds.NewProvider(command))
+}
+
+// invalidator is implemented by aws.CredentialsCache.
+type invalidator interface {
+	Invalidate()
+}
+
+// refreshingCredentialsProvider wraps a caching credentials provider and refreshes the cached credentials
+// once they are within the refresh window of their expiry.
+// A single refreshingCredentialsProvider is shared by all AWS API clients so that credentials
+// sourced from IAM Identity Center (SSO) or an external process are refreshed once, in-place, during long-running operations.
+// If a refresh fails the current credentials continue to be used until they expire.
+type refreshingCredentialsProvider struct {
+	provider      aws.CredentialsProvider
+	refreshWindow time.Duration
+
+	mu          sync.Mutex
+	creds       aws.Credentials
+	lastAttempt time.Time
+}
+
+// newRefreshingCredentialsProvider returns a credentials cache that refreshes the specified provider's cached credentials early.
+// The result is an *aws.CredentialsCache so that the AWS SDK for Go v2 does not wrap it in a separate cache for each API client.
+// The specified provider is returned unchanged if it does not cache credentials or the refresh window is not positive.
+func newRefreshingCredentialsProvider(provider aws.CredentialsProvider, refreshWindow time.Duration) aws.CredentialsProvider {
+	if _, ok := provider.(invalidator); !ok || refreshWindow <= 0 {
+		return provider
+	}
+
+	return aws.NewCredentialsCache(&refreshingCredentialsProvider{
+		provider:      provider,
+		refreshWindow: refreshWindow,
+	}, func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = refreshWindow
+	})
+}
+
+func (p *refreshingCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.creds
+	if current.HasKeys() {
+		if !p.needsRefresh(current) {
+			return current, nil
+		}
+
+		// Don't retry a failed or ineffective refresh on every request.
+		if time.Since(p.lastAttempt) < credentialsRefreshRetryInterval && !current.Expired() {
+			return current, nil
+		}
+
+		tflog.Debug(ctx, "Refreshing AWS credentials", map[string]any{
+			"tf_aws.credentials_source":  current.Source,
+			"tf_aws.credentials_expires": current.Expires,
+		})
+
+		p.provider.(invalidator).Invalidate()
+	}
+
+	p.lastAttempt = time.Now()
+	creds, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		if current.HasKeys() && !current.Expired() {
+			tflog.Warn(ctx, "refreshing AWS credentials", map[string]any{
+				"error": err.Error(),
+			})
+
+			return current, nil
+		}
+
+		return aws.Credentials{}, err
+	}
+
+	p.creds = creds
+
+	return creds, nil
+}
+
+func (p *refreshingCredentialsProvider) needsRefresh(creds aws.Credentials) bool {
+	return creds.CanExpire && time.Until(creds.Expires) < p.refreshWindow
+}
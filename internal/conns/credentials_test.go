@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// mockCredentialsProvider returns a new set of credentials, expiring after the configured lifetime, from each call to Retrieve.
+type mockCredentialsProvider struct {
+	lifetime time.Duration
+	err      error
+	calls    int
+}
+
+func (p *mockCredentialsProvider) Retrieve(context.Context) (aws.Credentials, error) {
+	p.calls++
+
+	if p.err != nil {
+		return aws.Credentials{}, p.err
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    string(rune('a' + p.calls)),
+		CanExpire:       true,
+		Expires:         time.Now().Add(p.lifetime),
+	}, nil
+}
+
+func TestNewRefreshingCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	static := credentials.NewStaticCredentialsProvider("AKID", "SECRET", "")
+	if _, ok := newRefreshingCredentialsProvider(static, time.Minute).(credentials.StaticCredentialsProvider); !ok {
+		t.Errorf("static credentials provider was wrapped")
+	}
+
+	cache := aws.NewCredentialsCache(&mockCredentialsProvider{lifetime: time.Hour})
+	if got := newRefreshingCredentialsProvider(cache, 0); got != cache {
+		t.Errorf("credentials provider was wrapped with zero refresh window")
+	}
+
+	got := newRefreshingCredentialsProvider(cache, time.Minute)
+	if _, ok := got.(*aws.CredentialsCache); !ok {
+		t.Errorf("got %T, want *aws.CredentialsCache", got)
+	}
+}
+
+func TestRefreshingCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		lifetime    time.Duration
+		wantRefresh bool
+	}{
+		"outside refresh window": {
+			lifetime: time.Hour,
+		},
+		"inside refresh window": {
+			lifetime:    2 * time.Minute,
+			wantRefresh: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &mockCredentialsProvider{lifetime: testCase.lifetime}
+			p := &refreshingCredentialsProvider{
+				provider:      aws.NewCredentialsCache(mock),
+				refreshWindow: 5 * time.Minute,
+			}
+
+			first, err := p.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Force the retry interval to have elapsed.
+			p.lastAttempt = time.Time{}
+
+			second, err := p.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := first.SessionToken != second.SessionToken, testCase.wantRefresh; got != want {
+				t.Errorf("refreshed = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRefreshingCredentialsProvider_refreshError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mock := &mockCredentialsProvider{lifetime: 2 * time.Minute}
+	p := &refreshingCredentialsProvider{
+		provider:      aws.NewCredentialsCache(mock),
+		refreshWindow: 5 * time.Minute,
+	}
+
+	first, err := p.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mock.err = errors.New("token expired")
+	p.lastAttempt = time.Time{}
+
+	second, err := p.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := second.SessionToken, first.SessionToken; got != want {
+		t.Errorf("SessionToken = %q, want %q", got, want)
+	}
+
+	// The failed refresh is not retried within the retry interval.
+	calls := mock.calls
+	if _, err := p.Retrieve(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := mock.calls, calls; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
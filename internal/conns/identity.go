@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IdentityValues returns the values of a resource's identity attributes.
+// The value of the identity attribute is the resource's import ID.
+func (c *AWSClient) IdentityValues(ctx context.Context, identity types.Identity, id string) map[string]string {
+	values := make(map[string]string, len(identity.Attributes))
+
+	for _, v := range identity.Attributes {
+		switch v.Name {
+		case identity.IdentityAttribute:
+			values[v.Name] = id
+		case names.AttrAccountID:
+			values[v.Name] = c.AccountID(ctx)
+		case names.AttrRegion:
+			values[v.Name] = c.Region(ctx)
+		}
+	}
+
+	return values
+}
+
+// ImportIDFromIdentity returns the import ID of the resource with the specified identity attribute values.
+// Any AWS account ID and Region in the identity must match the provider's.
+// If the resource's Region can be overridden, a different Region is instead added to the import ID as a Region suffix.
+func (c *AWSClient) ImportIDFromIdentity(ctx context.Context, identity types.Identity, values map[string]string, isRegionOverrideEnabled bool) (string, error) {
+	id := values[identity.IdentityAttribute]
+	if id == "" {
+		return "", fmt.Errorf("identity attribute %q is required", identity.IdentityAttribute)
+	}
+
+	if v := values[names.AttrAccountID]; v != "" {
+		if accountID := c.AccountID(ctx); v != accountID {
+			return "", fmt.Errorf("identity's AWS account ID (%s) does not match the provider's (%s)", v, accountID)
+		}
+	}
+
+	if v := values[names.AttrRegion]; v != "" {
+		if region := c.Region(ctx); v != region {
+			if !isRegionOverrideEnabled {
+				return "", fmt.Errorf("identity's Region (%s) does not match the provider's configured Region (%s)", v, region)
+			}
+
+			id += ImportIDRegionSeparator + v
+		}
+	}
+
+	return id, nil
+}
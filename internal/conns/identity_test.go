@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIdentityValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		accountID: "123456789012",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		identity types.Identity
+		want     map[string]string
+	}{
+		"ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			want: map[string]string{
+				names.AttrARN: "test-id",
+			},
+		},
+		"Regional single parameter": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			want: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrName:      "test-id",
+				names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+			},
+		},
+		"global single parameter": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			want: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrName:      "test-id",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := c.IdentityValues(ctx, testCase.identity, "test-id"), testCase.want; !maps.Equal(got, want) {
+				t.Errorf("IdentityValues = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestImportIDFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		accountID: "123456789012",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+	identity := types.RegionalSingleParameterIdentity(names.AttrName)
+
+	testCases := map[string]struct {
+		values                  map[string]string
+		isRegionOverrideEnabled bool
+		wantID                  string
+		wantErr                 bool
+	}{
+		"identity attribute only": {
+			values: map[string]string{
+				names.AttrName: "test-name",
+			},
+			wantID: "test-name",
+		},
+		"matching account and Region": {
+			values: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrName:      "test-name",
+				names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+			},
+			wantID: "test-name",
+		},
+		"no identity attribute": {
+			values: map[string]string{
+				names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+			},
+			wantErr: true,
+		},
+		"different account": {
+			values: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "test-name",
+			},
+			wantErr: true,
+		},
+		"different Region": {
+			values: map[string]string{
+				names.AttrName:   "test-name",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantErr: true,
+		},
+		"different Region with Region override": {
+			values: map[string]string{
+				names.AttrName:   "test-name",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			isRegionOverrideEnabled: true,
+			wantID:                  "test-name@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := c.ImportIDFromIdentity(ctx, identity, testCase.values, testCase.isRegionOverrideEnabled)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := got, testCase.wantID; got != want {
+				t.Errorf("ImportIDFromIdentity = %q, want %q", got, want)
+			}
+		})
+	}
+}
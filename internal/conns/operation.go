@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes that, by convention,
+// identify operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// IsReadOnlyOperation returns whether the specified AWS API operation does not modify resources.
+// Classification is based on the operation name, e.g. "DescribeInstances" or "ListBuckets".
+func IsReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if v, ok := strings.CutPrefix(operation, prefix); ok && (v == "" || !isLowerASCII(v[0])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsMutatingOperation returns whether the specified AWS API operation may modify resources.
+func IsMutatingOperation(operation string) bool {
+	return !IsReadOnlyOperation(operation)
+}
+
+func isLowerASCII(b byte) bool {
+	return 'a' <= b && b <= 'z'
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		operation string
+		expected  bool
+	}{
+		{"BatchGetItem", true},
+		{"DescribeInstances", true},
+		{"GetObject", true},
+		{"HeadBucket", true},
+		{"ListBuckets", true},
+		{"Query", true},
+		{"Scan", true},
+		{"SearchResources", true},
+		{"ChangeResourceRecordSets", false},
+		{"CreateBucket", false},
+		{"DeleteRole", false},
+		{"PutObject", false},
+		{"Getaway", false},
+		{"Listen", false},
+		{"", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsReadOnlyOperation(testCase.operation), testCase.expected; got != want {
+				t.Errorf("IsReadOnlyOperation(%q) = %t, want %t", testCase.operation, got, want)
+			}
+			if got, want := IsMutatingOperation(testCase.operation), !testCase.expected; got != want {
+				t.Errorf("IsMutatingOperation(%q) = %t, want %t", testCase.operation, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ReadOnlyError is returned when the provider is configured with `read_only = true`
+// and a resource attempts to call a mutating AWS API operation.
+type ReadOnlyError struct {
+	ServicePackageName string
+	Operation          string
+	ResourceType       string // Terraform type name of the caller, if known.
+	ResourceOperation  string // CRUD operation being run by the caller, if known.
+}
+
+func (e *ReadOnlyError) Error() string {
+	var caller string
+	if e.ResourceType != "" {
+		caller = " called by " + e.ResourceType
+		if e.ResourceOperation != "" {
+			caller += fmt.Sprintf(" (%s)", e.ResourceOperation)
+		}
+	}
+
+	return fmt.Sprintf("provider is read-only: mutating API operation %s:%s%s is not allowed; see the provider's read_only_allowed configuration", e.ServicePackageName, e.Operation, caller)
+}
+
+// readOnlyMiddleware returns an AWS SDK for Go v2 API option that rejects calls to mutating API operations.
+// Calls to the specified operations are allowed.
+// The middleware runs in the Initialize step so that rejected calls are neither sent nor retried.
+func readOnlyMiddleware(servicePackageName string, allowedOperations []string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformReadOnly", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if IsReadOnlyOperation(operation) || slices.Contains(allowedOperations, operation) {
+				return next.HandleInitialize(ctx, in)
+			}
+
+			err := &ReadOnlyError{
+				Operation:          operation,
+				ServicePackageName: servicePackageName,
+			}
+			if v, ok := FromContext(ctx); ok {
+				err.ResourceType = v.TypeName()
+				err.ResourceOperation = v.ResourceOperation()
+			}
+
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}), middleware.After)
+	}
+}
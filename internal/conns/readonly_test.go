@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name              string
+		Context           func(context.Context) context.Context
+		Operation         string
+		AllowedOperations []string
+		ExpectedError     string
+	}{
+		{
+			Name:      "read-only",
+			Context:   func(ctx context.Context) context.Context { return ctx },
+			Operation: "DescribeInstances",
+		},
+		{
+			Name:          "mutating",
+			Context:       func(ctx context.Context) context.Context { return ctx },
+			Operation:     "RunInstances",
+			ExpectedError: "provider is read-only: mutating API operation test:RunInstances is not allowed; see the provider's read_only_allowed configuration",
+		},
+		{
+			Name: "mutating from resource",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "test", "Instance", "aws_test_instance")
+				return WithResourceOperation(ctx, "Create")
+			},
+			Operation:     "RunInstances",
+			ExpectedError: "provider is read-only: mutating API operation test:RunInstances called by aws_test_instance (Create) is not allowed; see the provider's read_only_allowed configuration",
+		},
+		{
+			Name:              "mutating allowed",
+			Context:           func(ctx context.Context) context.Context { return ctx },
+			Operation:         "AssumeRole",
+			AllowedOperations: []string{"AssumeRole"},
+		},
+		{
+			Name:              "mutating not allowed",
+			Context:           func(ctx context.Context) context.Context { return ctx },
+			Operation:         "RunInstances",
+			AllowedOperations: []string{"AssumeRole"},
+			ExpectedError:     "provider is read-only: mutating API operation test:RunInstances is not allowed; see the provider's read_only_allowed configuration",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			stack := middleware.NewStack(testCase.Operation, func() any { return struct{}{} })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.Operation}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := readOnlyMiddleware("test", testCase.AllowedOperations)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+				called = true
+				return struct{}{}, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(testCase.Context(context.Background()), struct{}{})
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !called {
+					t.Error("expected API call to be sent")
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if _, ok := errs.As[*ReadOnlyError](err); !ok {
+				t.Errorf("expected ReadOnlyError, got %T", err)
+			}
+			if got, want := err.Error(), testCase.ExpectedError; got != want {
+				t.Errorf("got error %q, expected %q", got, want)
+			}
+			if called {
+				t.Error("expected API call not to be sent")
+			}
+		})
+	}
+}
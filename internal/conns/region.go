@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// ImportIDRegionSeparator separates a resource's import ID from the Region in which the resource exists,
+// e.g. "vpc-12345678@us-west-2".
+const ImportIDRegionSeparator = "@"
+
+var regionNameRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d{1,2}$`)
+
+// IsValidRegionName returns whether the specified value is syntactically a valid AWS Region name.
+func IsValidRegionName(region string) bool {
+	return regionNameRegexp.MatchString(region)
+}
+
+// SplitImportIDRegion splits an import ID with an optional Region suffix into the resource's import ID
+// and the Region in which the resource exists.
+// An empty Region is returned if the import ID has no Region suffix.
+func SplitImportIDRegion(id string) (string, string) {
+	if i := strings.LastIndex(id, ImportIDRegionSeparator); i > 0 {
+		if region := id[i+len(ImportIDRegionSeparator):]; IsValidRegionName(region) {
+			return id[:i], region
+		}
+	}
+
+	return id, ""
+}
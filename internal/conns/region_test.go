@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id         string
+		wantID     string
+		wantRegion string
+	}{
+		"empty": {
+			id:     "",
+			wantID: "",
+		},
+		"no suffix": {
+			id:     "vpc-12345678",
+			wantID: "vpc-12345678",
+		},
+		"Region suffix": {
+			id:         "vpc-12345678@us-west-2", //lintignore:AWSAT003
+			wantID:     "vpc-12345678",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"GovCloud Region suffix": {
+			id:         "vpc-12345678@us-gov-west-1", //lintignore:AWSAT003
+			wantID:     "vpc-12345678",
+			wantRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		"email address": {
+			id:     "user@example.com",
+			wantID: "user@example.com",
+		},
+		"email address with Region suffix": {
+			id:         "user@example.com@eu-west-1", //lintignore:AWSAT003
+			wantID:     "user@example.com",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"Region only": {
+			id:     "@us-west-2", //lintignore:AWSAT003
+			wantID: "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.id)
+
+			if gotID != testCase.wantID {
+				t.Errorf("ID = %q, want %q", gotID, testCase.wantID)
+			}
+			if gotRegion != testCase.wantRegion {
+				t.Errorf("Region = %q, want %q", gotRegion, testCase.wantRegion)
+			}
+		})
+	}
+}
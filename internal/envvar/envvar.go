@@ -66,6 +66,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests that can run against the in-process fake AWS backend instead of AWS
+	// Any non-empty value sends all AWS API requests to the fake backend
+	AccFakeBackend = "TF_ACC_FAKE_BACKEND"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"iter"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,7 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 // Expand  = TF -->  AWS
@@ -32,6 +34,14 @@ type TypedExpander interface {
 	ExpandTo(ctx context.Context, targetType reflect.Type) (any, diag.Diagnostics)
 }
 
+// UnionMembers is implemented by types that expand to an AWS SDK for Go v2 union (tagged interface) type.
+// UnionMembers returns a value of each of the union's member types, e.g. `&awstypes.ConfigurationMemberCognitoUserPoolConfiguration{}`.
+// Members that don't implement the target interface are ignored, so a single type can expand to more than one union.
+// Exactly one of the type's fields must be set; that field is expanded into the `Value` of the member whose name matches the field's.
+type UnionMembers interface {
+	UnionMembers() []any
+}
+
 // Expand "expands" a resource's "business logic" data structure,
 // implemented using Terraform Plugin Framework data types, into
 // an AWS SDK for Go v2 API data structure.
@@ -138,6 +148,12 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(UnionMembers); ok && vTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionMembers")
+		diags.Append(expandUnion(ctx, sourcePath, reflect.Indirect(valFrom), fromUnion, targetPath, vTo, expander)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -1152,6 +1168,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := valFrom.Interface().(UnionMembers); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionMembers")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, valTo, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1318,6 +1340,111 @@ func expandExpander(ctx context.Context, fromExpander Expander, toVal reflect.Va
 	return diags
 }
 
+// expandUnion copies the single set field of a Plugin Framework struct value to the matching member of an AWS API union (tagged interface) value.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, fromUnion UnionMembers, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+	typeTo := valTo.Type()
+
+	var setFields []reflect.StructField
+	for field := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
+		if isUnionMemberFieldSet(valFrom.FieldByIndex(field.Index)) {
+			setFields = append(setFields, field)
+		}
+	}
+
+	switch n := len(setFields); n {
+	case 0:
+		tflog.SubsystemError(ctx, subsystemName, "No union member set")
+		diags.Append(diagExpandingUnionNoMemberSet(typeFrom, typeTo))
+		return diags
+
+	case 1:
+
+	default:
+		fieldNames := tfslices.ApplyToAll(setFields, func(field reflect.StructField) string {
+			return field.Name
+		})
+		tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+			"fields": fieldNames,
+		})
+		diags.Append(diagExpandingUnionMultipleMembersSet(typeFrom, typeTo, fieldNames))
+		return diags
+	}
+
+	fromField := setFields[0]
+	fromFieldName := fromField.Name
+
+	var memberType reflect.Type
+	for _, member := range fromUnion.UnionMembers() {
+		t := reflect.TypeOf(member)
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if !reflect.PointerTo(t).Implements(typeTo) {
+			continue
+		}
+
+		if name, ok := unionMemberName(typeTo, t); ok && strings.EqualFold(name, fromFieldName) {
+			memberType = t
+			break
+		}
+	}
+
+	if memberType == nil {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+			logAttrKeySourceFieldname: fromFieldName,
+		})
+		diags.Append(diagExpandingUnionNoCorrespondingMember(typeFrom, fromFieldName, typeTo))
+		return diags
+	}
+
+	valueField, ok := memberType.FieldByName(unionMemberValueFieldName)
+	if !ok {
+		diags.Append(diagExpandingUnionNoCorrespondingMember(typeFrom, fromFieldName, typeTo))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: fromFieldName,
+		logAttrKeyTargetFieldname: memberType.Name(),
+	})
+
+	to := reflect.New(memberType)
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath, to.Elem().FieldByIndex(valueField.Index), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if memberType.Implements(typeTo) {
+		valTo.Set(to.Elem())
+	} else {
+		valTo.Set(to)
+	}
+
+	return diags
+}
+
+// isUnionMemberFieldSet returns whether the specified Plugin Framework value holds a union member value.
+func isUnionMemberFieldSet(v reflect.Value) bool {
+	value, ok := v.Interface().(attr.Value)
+	if !ok {
+		return !v.IsZero()
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	if value, ok := value.(valueWithElementsAs); ok {
+		return len(value.Elements()) > 0
+	}
+
+	return true
+}
+
 func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, toVal reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1372,6 +1499,30 @@ func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagExpandingUnionNoMemberSet(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Union Configuration",
+		fmt.Sprintf("Exactly one of the fields of %q must be set to expand to %q, but none were set.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
+func diagExpandingUnionMultipleMembersSet(sourceType, targetType reflect.Type, fieldNames []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Union Configuration",
+		fmt.Sprintf("Exactly one of the fields of %q must be set to expand to %q, but %d were set: %s.", fullTypeName(sourceType), fullTypeName(targetType), len(fieldNames), strings.Join(fieldNames, ", ")),
+	)
+}
+
+func diagExpandingUnionNoCorrespondingMember(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of type %q has no corresponding member of union type %q.", fieldName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
 func diagExpandsToNil(expanderType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -5958,3 +5958,149 @@ func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases) {
 		})
 	}
 }
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetUnion awsUnion
+
+	testCases := map[string]struct {
+		Source        any
+		Target        any
+		expectedDiags diag.Diagnostics
+		WantTarget    any
+	}{
+		"top level object member": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{Field1: types.StringValue("value1")},
+				}),
+				String: types.StringNull(),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberObject{
+				Value: awsSingleStringValue{Field1: "value1"},
+			}),
+		},
+		"single list Source and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberString{Value: "value1"},
+			},
+		},
+		"single list Source and single union Target empty list is not set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+						String: types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberString{Value: "value1"},
+			},
+		},
+		"single list Source and other single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsOtherUnionSingle{},
+			WantTarget: &awsOtherUnionSingle{
+				Field1: &awsOtherUnionMemberString{Value: "value1"},
+			},
+		},
+		"list Source and slice of union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value2")},
+						}),
+						String: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{Value: "value1"},
+					&awsUnionMemberObject{Value: awsSingleStringValue{Field1: "value2"}},
+				},
+			},
+		},
+		"no member set": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				String: types.StringNull(),
+			},
+			Target: &targetUnion,
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionNoMemberSet(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+			},
+		},
+		"multiple members set": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{Field1: types.StringValue("value1")},
+				}),
+				String: types.StringValue("value2"),
+			},
+			Target: &targetUnion,
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMultipleMembersSet(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion](), []string{"Object", "String"}),
+			},
+		},
+		"no corresponding member": {
+			Source: tfUnionMissingMember{
+				Missing: types.StringValue("value1"),
+			},
+			Target: &targetUnion,
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionNoCorrespondingMember(reflect.TypeFor[tfUnionMissingMember](), "Missing", reflect.TypeFor[awsUnion]()),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.Source, testCase.Target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
@@ -192,7 +192,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -611,7 +611,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -655,7 +655,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1217,7 +1217,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1238,6 +1238,21 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	}
 
 	toFlattener, ok := to.(Flattener)
+	if !ok && isUnion(vFrom) {
+		diags.Append(flattenUnion(ctx, sourcePath, vFrom, targetPath, to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -1470,7 +1485,11 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
-		diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		if _, ok := target.(Flattener); !ok && isUnion(vFrom.Index(i)) {
+			diags.Append(flattenUnion(ctx, sourcePath, vFrom.Index(i), targetPath, target, flattener)...)
+		} else {
+			diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		}
 		if diags.HasError() {
 			return diags
 		}
@@ -1567,6 +1586,75 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	return diags
 }
 
+// isUnion returns whether the specified AWS API value is a non-nil union (tagged interface) value.
+func isUnion(v reflect.Value) bool {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return false
+	}
+
+	member := v.Elem().Type()
+	if member.Kind() == reflect.Pointer {
+		member = member.Elem()
+	}
+
+	if member.Name() == unknownUnionMemberTypeName {
+		return true
+	}
+
+	if _, ok := unionMemberName(v.Type(), member); !ok {
+		return false
+	}
+
+	_, ok := member.FieldByName(unionMemberValueFieldName)
+	return ok
+}
+
+// flattenUnion copies the value of an AWS API union (tagged interface) member to the field of `to` whose name matches the member's.
+func flattenUnion(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, _, valTo, d := autoFlexValues(ctx, vFrom.Interface(), to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valMember := reflect.Indirect(vFrom.Elem())
+	typeMember := valMember.Type()
+
+	if typeMember.Name() == unknownUnionMemberTypeName {
+		tflog.SubsystemWarn(ctx, subsystemName, "Unexpected tagged union member", map[string]any{
+			"tag": valMember.FieldByName("Tag").Interface(),
+		})
+		return diags
+	}
+
+	memberName, _ := unionMemberName(vFrom.Type(), typeMember)
+	toField, ok := findFieldFuzzy(ctx, memberName, typeMember, valTo.Type(), flexer)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: memberName,
+		})
+		diags.Append(diagFlatteningUnionNoCorrespondingField(typeMember, valTo.Type()))
+		return diags
+	}
+	toFieldName := toField.Name
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: typeMember.Name(),
+		logAttrKeyTargetFieldname: toFieldName,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath, valMember.FieldByName(unionMemberValueFieldName), targetPath.AtName(toFieldName), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -1762,6 +1850,16 @@ func diagFlatteningMarshalSmithyDocument(sourceType reflect.Type, err error) dia
 	)
 }
 
+func diagFlatteningUnionNoCorrespondingField(memberType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member of type %q has no corresponding field in %q.", fullTypeName(memberType), fullTypeName(targetType)),
+	)
+}
+
 func DiagFlatteningIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
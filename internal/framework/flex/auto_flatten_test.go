@@ -5510,3 +5510,120 @@ type rootSetNestedObjectModel struct {
 type nestedModel struct {
 	Field1 types.String `tfsdk:"field1"`
 }
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		Source        any
+		Target        any
+		expectedDiags diag.Diagnostics
+		WantTarget    any
+	}{
+		"object member to single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObject{
+					Value: awsSingleStringValue{Field1: "value1"},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+						String: types.StringNull(),
+					},
+				}),
+			},
+		},
+		"string member to single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{Value: "value1"},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+				}),
+			},
+		},
+		"nil union to single list Target": {
+			Source: awsUnionSingle{},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"unknown member to single list Target": {
+			Source: awsUnionSingle{
+				Field1: &UnknownUnionMember{Tag: "unknown"},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringNull(),
+					},
+				}),
+			},
+		},
+		"slice of union to list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{Value: "value1"},
+					&awsUnionMemberObject{Value: awsSingleStringValue{Field1: "value2"}},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value2")},
+						}),
+						String: types.StringNull(),
+					},
+				}),
+			},
+		},
+		"no corresponding field": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{Value: "value1"},
+			},
+			Target: &tfListNestedObject[tfUnionMissingMember]{},
+			expectedDiags: diag.Diagnostics{
+				diagFlatteningUnionNoCorrespondingField(reflect.TypeFor[awsUnionMemberString](), reflect.TypeFor[tfUnionMissingMember]()),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(ctx, testCase.Source, testCase.Target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
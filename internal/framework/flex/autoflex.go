@@ -24,6 +24,9 @@ const (
 	fieldNameSuffixRecurse fieldNamePrefixCtxKey = "FIELD_NAME_SUFFIX_RECURSE"
 
 	mapBlockKeyFieldName = "MapBlockKey"
+
+	unionMemberValueFieldName  = "Value"
+	unknownUnionMemberTypeName = "UnknownUnionMember"
 )
 
 // Expand  = TF -->  AWS
@@ -144,6 +147,26 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	return reflect.StructField{}, false
 }
 
+// unionMemberName returns the member name of an AWS SDK for Go v2 union member type.
+// Union member types are named `<Union>Member<Name>`, e.g. `ConfigurationMemberCognitoUserPoolConfiguration`.
+func unionMemberName(unionType, memberType reflect.Type) (string, bool) {
+	if memberType.Kind() == reflect.Pointer {
+		memberType = memberType.Elem()
+	}
+
+	if unionType.PkgPath() != memberType.PkgPath() {
+		return "", false
+	}
+
+	prefix := unionType.Name() + "Member"
+	name, ok := strings.CutPrefix(memberType.Name(), prefix)
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
 func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	_, ok := structType.FieldByName(field)
 	return ok
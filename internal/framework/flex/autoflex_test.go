@@ -702,3 +702,76 @@ type tfSetOfStringEnum struct {
 type awsSliceOfStringEnum struct {
 	Field1 []testEnum
 }
+
+type tfUnion struct {
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+	String types.String                                         `tfsdk:"string"`
+}
+
+var _ UnionMembers = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberObject{},
+		&awsUnionMemberString{},
+		&awsOtherUnionMemberString{},
+	}
+}
+
+type tfUnionMissingMember struct {
+	Missing types.String `tfsdk:"missing"`
+}
+
+var _ UnionMembers = tfUnionMissingMember{}
+
+func (tfUnionMissingMember) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberObject{},
+		&awsUnionMemberString{},
+	}
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsOtherUnionSingle struct {
+	Field1 awsOtherUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsOtherUnion interface {
+	isAWSOtherUnion()
+}
+
+type awsOtherUnionMemberString struct {
+	Value string
+}
+
+func (*awsOtherUnionMemberString) isAWSOtherUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type UnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*UnknownUnionMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationBetweenValidator validates that a string Attribute's value is a duration within a range.
+type durationBetweenValidator struct {
+	min, max time.Duration
+}
+
+// Description describes the validation in plain text formatting.
+func (validator durationBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a duration between %s and %s, inclusive", validator.min, validator.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator durationBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator durationBetweenValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil || d < validator.min || d > validator.max {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// DurationBetween returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which can be parsed as a time.Duration.
+//   - Is between min and max, inclusive.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func DurationBetween(min, max time.Duration) validator.String {
+	return durationBetweenValidator{
+		min: min,
+		max: max,
+	}
+}
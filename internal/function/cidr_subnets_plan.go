@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC subnet size limits reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// subnetMinPrefixLength is the largest IPv4 subnet permitted in a VPC
+	subnetMinPrefixLength = 16
+	// subnetMaxPrefixLength is the smallest IPv4 subnet permitted in a VPC
+	subnetMaxPrefixLength = 28
+	// subnetIPv6PrefixLength is the size of every IPv6 subnet carved from the VPC's IPv6 CIDR block
+	subnetIPv6PrefixLength = 64
+)
+
+var (
+	cidrSubnetsPlanSubnetAttrTypes = map[string]attr.Type{
+		"cidr_block":      types.StringType,
+		"ipv6_cidr_block": types.StringType,
+	}
+	cidrSubnetsPlanSubnetType = types.ObjectType{AttrTypes: cidrSubnetsPlanSubnetAttrTypes}
+	cidrSubnetsPlanTierType   = types.MapType{ElemType: cidrSubnetsPlanSubnetType}
+)
+
+var _ function.Function = cidrSubnetsPlanFunction{}
+
+func NewCIDRSubnetsPlanFunction() function.Function {
+	return &cidrSubnetsPlanFunction{}
+}
+
+type cidrSubnetsPlanFunction struct{}
+
+func (f cidrSubnetsPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_plan"
+}
+
+func (f cidrSubnetsPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_plan Function",
+		MarkdownDescription: "Plans a deterministic, non-overlapping subnet layout for a VPC. One subnet is allocated " +
+			"per tier in each Availability Zone, optionally with an IPv6 /64 carved from the VPC's IPv6 CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Names of the Availability Zones in which to place subnets",
+				ElementType:         types.StringType,
+			},
+			function.MapParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Map of tier name to the IPv4 prefix length of each subnet in that tier",
+				ElementType:         types.Int64Type,
+			},
+			function.StringParameter{
+				Name:                "ipv6_cidr_block",
+				MarkdownDescription: "IPv6 CIDR block of the VPC, typically an Amazon-provided /56. May be null",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: cidrSubnetsPlanTierType,
+		},
+	}
+}
+
+func (f cidrSubnetsPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones []string
+	var tiers map[string]int64
+	var ipv6CIDRBlock types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &tiers, &ipv6CIDRBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	plan, err := planSubnets(cidrBlock, availabilityZones, tiers, ipv6CIDRBlock.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	tierValues := make(map[string]attr.Value, len(plan))
+	for tier, subnets := range plan {
+		subnetValues := make(map[string]attr.Value, len(subnets))
+		for az, subnet := range subnets {
+			ipv6 := types.StringNull()
+			if subnet.ipv6CIDRBlock != "" {
+				ipv6 = types.StringValue(subnet.ipv6CIDRBlock)
+			}
+
+			v, d := types.ObjectValue(cidrSubnetsPlanSubnetAttrTypes, map[string]attr.Value{
+				"cidr_block":      types.StringValue(subnet.cidrBlock),
+				"ipv6_cidr_block": ipv6,
+			})
+			if d.HasError() {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+				return
+			}
+			subnetValues[az] = v
+		}
+
+		v, d := types.MapValue(cidrSubnetsPlanSubnetType, subnetValues)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		tierValues[tier] = v
+	}
+
+	result, d := types.MapValue(cidrSubnetsPlanTierType, tierValues)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type plannedSubnet struct {
+	cidrBlock     string
+	ipv6CIDRBlock string
+}
+
+// planSubnets allocates one IPv4 subnet per tier per Availability Zone from the
+// VPC CIDR block. Tiers are allocated largest first (ties broken by name) and,
+// within a tier, in Availability Zone order. Allocating in order of decreasing
+// size keeps every subnet naturally aligned without leaving gaps, so the layout
+// depends only on the inputs. When an IPv6 CIDR block is supplied, consecutive
+// /64s are assigned in the same order.
+func planSubnets(cidrBlock string, availabilityZones []string, tiers map[string]int64, ipv6CIDRBlock string) (map[string]map[string]plannedSubnet, error) {
+	vpc, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !vpc.Addr().Is4() {
+		return nil, fmt.Errorf("cidr_block %q must be an IPv4 CIDR block", cidrBlock)
+	}
+
+	if len(availabilityZones) == 0 {
+		return nil, fmt.Errorf("at least one Availability Zone is required")
+	}
+	for i, az := range availabilityZones {
+		if az == "" {
+			return nil, fmt.Errorf("availability_zones[%d] must not be empty", i)
+		}
+		if slices.Index(availabilityZones, az) != i {
+			return nil, fmt.Errorf("duplicate Availability Zone %q", az)
+		}
+	}
+
+	if len(tiers) == 0 {
+		return nil, fmt.Errorf("at least one tier is required")
+	}
+
+	names := slices.Collect(maps.Keys(tiers))
+	for _, name := range names {
+		switch prefixLength := tiers[name]; {
+		case prefixLength < subnetMinPrefixLength || prefixLength > subnetMaxPrefixLength:
+			return nil, fmt.Errorf("tier %q prefix length /%d must be between /%d and /%d", name, prefixLength, subnetMinPrefixLength, subnetMaxPrefixLength)
+		case prefixLength < int64(vpc.Bits()):
+			return nil, fmt.Errorf("tier %q prefix length /%d is larger than VPC CIDR block %s", name, prefixLength, vpc)
+		}
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(cmp.Compare(tiers[a], tiers[b]), strings.Compare(a, b))
+	})
+
+	// Sizes are computed in 64 bits so that a full /16 of /16s cannot overflow.
+	var required uint64
+	for _, name := range names {
+		required += uint64(len(availabilityZones)) << (32 - tiers[name])
+	}
+	if available := uint64(1) << (32 - vpc.Bits()); required > available {
+		return nil, fmt.Errorf("requested layout needs %d IPv4 addresses (%d tiers across %d Availability Zones) but VPC CIDR block %s provides only %d", required, len(names), len(availabilityZones), vpc, available)
+	}
+
+	var ipv6Base uint64
+	var ipv6Available uint64
+	if ipv6CIDRBlock != "" {
+		ipv6, err := parseCIDRBlock(ipv6CIDRBlock)
+		if err != nil {
+			return nil, err
+		}
+		if !ipv6.Addr().Is6() {
+			return nil, fmt.Errorf("ipv6_cidr_block %q must be an IPv6 CIDR block", ipv6CIDRBlock)
+		}
+		if ipv6.Bits() > subnetIPv6PrefixLength {
+			return nil, fmt.Errorf("ipv6_cidr_block %q must be /%d or larger", ipv6CIDRBlock, subnetIPv6PrefixLength)
+		}
+
+		addr := ipv6.Addr().As16()
+		ipv6Base = binary.BigEndian.Uint64(addr[:8])
+		ipv6Available = uint64(1) << (subnetIPv6PrefixLength - ipv6.Bits())
+		if n := uint64(len(names) * len(availabilityZones)); n > ipv6Available {
+			return nil, fmt.Errorf("requested layout needs %d IPv6 /%d subnets but ipv6_cidr_block %s provides only %d", n, subnetIPv6PrefixLength, ipv6, ipv6Available)
+		}
+	}
+
+	addr := vpc.Addr().As4()
+	next := uint64(binary.BigEndian.Uint32(addr[:]))
+	var ipv6Index uint64
+
+	plan := make(map[string]map[string]plannedSubnet, len(names))
+	for _, name := range names {
+		prefixLength := int(tiers[name])
+		size := uint64(1) << (32 - prefixLength)
+		plan[name] = make(map[string]plannedSubnet, len(availabilityZones))
+
+		for _, az := range availabilityZones {
+			var a [4]byte
+			binary.BigEndian.PutUint32(a[:], uint32(next))
+			subnet := plannedSubnet{
+				cidrBlock: netip.PrefixFrom(netip.AddrFrom4(a), prefixLength).String(),
+			}
+			next += size
+
+			if ipv6Available > 0 {
+				var a [16]byte
+				binary.BigEndian.PutUint64(a[:8], ipv6Base+ipv6Index)
+				subnet.ipv6CIDRBlock = netip.PrefixFrom(netip.AddrFrom16(a), subnetIPv6PrefixLength).String()
+				ipv6Index++
+			}
+
+			plan[name][az] = subnet
+		}
+	}
+
+	return plan, nil
+}
+
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	return prefix, nil
+}
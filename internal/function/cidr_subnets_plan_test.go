@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b"]`, `{ public = 24, private = 20 }`, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_a", "10.0.0.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.16.0/20"),
+					resource.TestCheckOutput("public_a", "10.0.32.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.33.0/24"),
+					resource.TestCheckOutput("public_b_ipv6", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b"]`, `{ public = 24, private = 20 }`, `"2600:1f14:abc:7d00::/56"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_a", "10.0.0.0/20"),
+					resource.TestCheckOutput("public_b", "10.0.33.0/24"),
+					resource.TestCheckOutput("public_b_ipv6", "2600:1f14:abc:7d03::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsPlanFunction_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsPlanFunctionConfig("10.0.0.0/24", `["us-west-2a", "us-west-2b"]`, `{ public = 25, private = 24 }`, "null"),
+				ExpectError: regexache.MustCompile(`provides[\s\n]*only[\s\n]*256`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsPlanFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsPlanFunctionConfig("10.0.0.1/16", `["us-west-2a"]`, `{ public = 24 }`, "null"),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsPlanFunctionConfig(cidrBlock, availabilityZones, tiers, ipv6CIDRBlock string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::cidr_subnets_plan(%[1]q, %[2]s, %[3]s, %[4]s)
+}
+
+output "private_a" {
+  value = local.plan["private"]["us-west-2a"].cidr_block
+}
+
+output "private_b" {
+  value = try(local.plan["private"]["us-west-2b"].cidr_block, "")
+}
+
+output "public_a" {
+  value = try(local.plan["public"]["us-west-2a"].cidr_block, "")
+}
+
+output "public_b" {
+  value = try(local.plan["public"]["us-west-2b"].cidr_block, "")
+}
+
+output "public_b_ipv6" {
+  value = try(local.plan["public"]["us-west-2b"].ipv6_cidr_block, null) == null ? "" : local.plan["public"]["us-west-2b"].ipv6_cidr_block
+}
+`, cidrBlock, availabilityZones, tiers, ipv6CIDRBlock)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// decodePolicy parses an IAM policy document. A single statement object is
+// accepted in place of a list of statements, as permitted by the IAM policy
+// grammar. Null entries in a list of statements are dropped.
+func decodePolicy(s string) (*tfiam.IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	switch statement := bytes.TrimSpace(raw.Statement); {
+	case len(statement) == 0, bytes.Equal(statement, []byte("null")):
+	case statement[0] == '{':
+		var v tfiam.IAMPolicyStatement
+		if err := json.Unmarshal(statement, &v); err != nil {
+			return nil, fmt.Errorf("parsing policy statement: %w", err)
+		}
+		doc.Statements = []*tfiam.IAMPolicyStatement{&v}
+	default:
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return nil, fmt.Errorf("parsing policy statements: %w", err)
+		}
+		doc.Statements = slices.DeleteFunc(doc.Statements, func(v *tfiam.IAMPolicyStatement) bool {
+			return v == nil
+		})
+	}
+
+	return doc, nil
+}
+
+// normalizePolicy rewrites an IAM policy document into a canonical form:
+// action, resource and principal lists are deduplicated and sorted, single
+// element lists are collapsed to strings, duplicate statements are removed
+// and the remaining statements are ordered by Sid and then by content.
+// A document without any statements is not a valid policy and is rejected.
+func normalizePolicy(doc *tfiam.IAMPolicyDoc) error {
+	type keyedStatement struct {
+		key       string
+		statement *tfiam.IAMPolicyStatement
+	}
+
+	if len(doc.Statements) == 0 {
+		return errors.New("policy contains no statements")
+	}
+
+	seen := make(map[string]struct{}, len(doc.Statements))
+	statements := make([]keyedStatement, 0, len(doc.Statements))
+
+	for _, statement := range doc.Statements {
+		var err error
+		for _, v := range []*any{&statement.Actions, &statement.NotActions, &statement.Resources, &statement.NotResources} {
+			if *v, err = normalizePolicyStringOrSlice(*v); err != nil {
+				return err
+			}
+		}
+		if statement.Principals, err = normalizePolicyPrincipals(statement.Principals); err != nil {
+			return err
+		}
+		if statement.NotPrincipals, err = normalizePolicyPrincipals(statement.NotPrincipals); err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(statement)
+		if err != nil {
+			return fmt.Errorf("encoding policy statement: %w", err)
+		}
+
+		key := string(b)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		statements = append(statements, keyedStatement{key: key, statement: statement})
+	}
+
+	slices.SortStableFunc(statements, func(a, b keyedStatement) int {
+		return cmp.Or(
+			strings.Compare(a.statement.Sid, b.statement.Sid),
+			strings.Compare(a.key, b.key),
+		)
+	})
+
+	doc.Statements = nil
+	for _, v := range statements {
+		doc.Statements = append(doc.Statements, v.statement)
+	}
+
+	return nil
+}
+
+// compactPolicy removes null entries from the list of statements in an IAM
+// policy document, leaving the rest of the document untouched.
+func compactPolicy(s string) (string, error) {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return "", fmt.Errorf("parsing policy: %w", err)
+	}
+
+	statements, ok := doc["Statement"].([]any)
+	if !ok || !slices.Contains(statements, nil) {
+		return s, nil
+	}
+
+	doc["Statement"] = slices.DeleteFunc(statements, func(v any) bool {
+		return v == nil
+	})
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encoding policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func encodePolicy(doc *tfiam.IAMPolicyDoc) (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encoding policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func normalizePolicyPrincipals(ps tfiam.IAMPolicyStatementPrincipalSet) (tfiam.IAMPolicyStatementPrincipalSet, error) {
+	if len(ps) == 0 {
+		return ps, nil
+	}
+
+	identifiers := make(map[string][]any)
+	for _, p := range ps {
+		identifiers[p.Type] = append(identifiers[p.Type], p.Identifiers)
+	}
+
+	out := make(tfiam.IAMPolicyStatementPrincipalSet, 0, len(identifiers))
+	for typ, v := range identifiers {
+		normalized, err := normalizePolicyStringOrSlice(v)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, tfiam.IAMPolicyStatementPrincipal{Type: typ, Identifiers: normalized})
+	}
+
+	slices.SortFunc(out, func(a, b tfiam.IAMPolicyStatementPrincipal) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	return out, nil
+}
+
+// normalizePolicyStringOrSlice flattens a string or (possibly nested) list of
+// strings into a sorted, deduplicated list, collapsing single element lists
+// to a plain string.
+func normalizePolicyStringOrSlice(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var values []string
+	var flatten func(any) error
+	flatten = func(v any) error {
+		switch v := v.(type) {
+		case nil:
+		case string:
+			values = append(values, v)
+		case []string:
+			values = append(values, v...)
+		case []any:
+			for _, v := range v {
+				if err := flatten(v); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unsupported policy element data type %T", v)
+		}
+		return nil
+	}
+
+	if err := flatten(v); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
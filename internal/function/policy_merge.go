@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges one or more IAM policy documents into a single normalized policy document. " +
+			"Statements with the same non-empty Sid are overridden by later documents.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy documents in JSON format",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(args) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("at least one policy document is required"))
+		return
+	}
+
+	merged := &tfiam.IAMPolicyDoc{}
+	for i, arg := range args {
+		doc, err := decodePolicy(arg)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("policy %d: %s", i+1, err)))
+			return
+		}
+
+		merged.Merge(doc)
+	}
+
+	if err := normalizePolicy(merged); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := encodePolicy(merged)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
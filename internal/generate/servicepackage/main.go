@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -74,6 +75,7 @@ func main() {
 		s := ServiceDatum{
 			GenerateClient:          l.GenerateClient(),
 			EndpointRegionOverrides: l.EndpointRegionOverrides(),
+			IsGlobal:                l.IsGlobal(),
 			GoV2Package:             l.GoV2Package(),
 			ProviderPackage:         p,
 			ProviderNameUpper:       l.ProviderNameUpper(),
@@ -120,11 +122,16 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttribute       string
+	IsARNIdentity           bool
+	IsGlobalResource        bool
+	RegionOverrideDisabled  bool
 }
 
 type ServiceDatum struct {
 	GenerateClient          bool
 	EndpointRegionOverrides map[string]string
+	IsGlobal                bool   // All resources are global
 	GoV2Package             string // AWS SDK for Go v2 package name
 	ProviderPackage         string
 	ProviderNameUpper       string
@@ -226,6 +233,70 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	// Then for Region annotations.
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["global"]; ok {
+				global, err := strconv.ParseBool(attr)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+
+				d.IsGlobalResource = global
+			}
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				enabled, err := strconv.ParseBool(attr)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+
+				d.RegionOverrideDisabled = !enabled
+			}
+		}
+	}
+
+	// Then for identity annotations.
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && (m[1] == "ArnIdentity" || m[1] == "IdentityAttribute") {
+			args := common.ParseArgs(m[3])
+
+			if d.IdentityAttribute != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := names.AttrARN
+			if len(args.Positional) > 0 {
+				attr = args.Positional[0]
+			} else if m[1] == "IdentityAttribute" {
+				v.errs = append(v.errs, fmt.Errorf("no identity attribute: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.IdentityAttribute = namesgen.ConstOrQuote(attr)
+			d.IsARNIdentity = m[1] == "ArnIdentity"
+
+			if attr, ok := args.Keyword["global"]; ok {
+				global, err := strconv.ParseBool(attr)
+				if err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+
+				d.IsGlobalResource = global
+			}
+		}
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -354,7 +425,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -24,7 +24,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		newTagPolicyProviderServerFactory(ctx, primary),
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// newIdentitySchema returns the Plugin Framework identity schema for the specified resource identity.
+func newIdentitySchema(identity itypes.Identity) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(identity.Attributes))
+
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: v.Required,
+			OptionalForImport: !v.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// setIdentity sets the resource's identity from its state.
+func setIdentity(ctx context.Context, c *conns.AWSClient, identity itypes.Identity, state tfsdk.State, target *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Terraform does not support resource identity or the resource has been deleted or not found.
+	if target == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root(identity.IdentityAttribute), &id)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for name, value := range c.IdentityValues(ctx, identity, id.ValueString()) {
+		diags.Append(target.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diags
+}
+
+// importIDFromIdentity returns the import ID of a resource imported by identity rather than by ID.
+func importIDFromIdentity(ctx context.Context, c *conns.AWSClient, identity itypes.Identity, isRegionOverrideEnabled bool, request resource.ImportStateRequest) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string, len(identity.Attributes))
+	for _, v := range identity.Attributes {
+		var value types.String
+		diags.Append(request.Identity.GetAttribute(ctx, path.Root(v.Name), &value)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		values[v.Name] = value.ValueString()
+	}
+
+	id, err := c.ImportIDFromIdentity(ctx, identity, values, isRegionOverrideEnabled)
+	if err != nil {
+		diags.AddError("Invalid Import Identity", err.Error())
+
+		return "", diags
+	}
+
+	return id, diags
+}
+
+// identityInterceptor sets the identity of resources that declare an identity.
+type identityInterceptor struct {
+	identity itypes.Identity
+}
+
+func newIdentityInterceptor(identity itypes.Identity) resourceInterceptor {
+	return &identityInterceptor{
+		identity: identity,
+	}
+}
+
+func (r identityInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(setIdentity(ctx, opts.c, r.identity, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(setIdentity(ctx, opts.c, r.identity, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(setIdentity(ctx, opts.c, r.identity, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+// importStateByIdentity imports a resource that declares an identity but does not implement ImportState.
+// The import ID is the value of the resource's identity attribute.
+func importStateByIdentity(ctx context.Context, c *conns.AWSClient, identity itypes.Identity, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if identity.IsARN {
+		v, err := arn.Parse(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("The import ID (%s) is not a valid ARN: %s", request.ID, err),
+			)
+
+			return
+		}
+
+		if !identity.IsGlobalResource && c != nil {
+			if region := c.Region(ctx); v.Region != region {
+				response.Diagnostics.AddError(
+					"Invalid Import ID",
+					fmt.Sprintf("The import ID's Region (%s) does not match the provider's configured Region (%s)", v.Region, region),
+				)
+
+				return
+			}
+		}
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(identity.IdentityAttribute), request, response)
+}
+
+// withRegionFromARNImportID returns a copy of the Context with the Region override set from the import ID
+// if the resource's identity is a Regional ARN.
+func withRegionFromARNImportID(ctx context.Context, identity itypes.Identity, id string) context.Context {
+	if !identity.IsARN || identity.IsGlobalResource {
+		return ctx
+	}
+
+	if v, err := arn.Parse(id); err == nil && v.Region != "" {
+		ctx = conns.WithOverrideRegion(ctx, v.Region)
+	}
+
+	return ctx
+}
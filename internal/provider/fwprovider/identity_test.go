@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	identitySchema := newIdentitySchema(itypes.RegionalSingleParameterIdentity(names.AttrName))
+
+	testCases := map[string]struct {
+		attribute string
+		required  bool
+	}{
+		names.AttrAccountID: {
+			attribute: names.AttrAccountID,
+		},
+		names.AttrName: {
+			attribute: names.AttrName,
+			required:  true,
+		},
+		names.AttrRegion: {
+			attribute: names.AttrRegion,
+		},
+	}
+
+	if got, want := len(identitySchema.Attributes), len(testCases); got != want {
+		t.Fatalf("expected %d identity attributes, got %d", want, got)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := identitySchema.Attributes[testCase.attribute].(identityschema.StringAttribute)
+			if !ok {
+				t.Fatalf("expected %s string identity attribute", testCase.attribute)
+			}
+
+			if got, want := v.RequiredForImport, testCase.required; got != want {
+				t.Errorf("RequiredForImport = %t, want %t", got, want)
+			}
+			if got, want := v.OptionalForImport, !testCase.required; got != want {
+				t.Errorf("OptionalForImport = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestListResourceList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	identity := itypes.GlobalSingleParameterIdentity(names.AttrName)
+
+	testCases := map[string]struct {
+		ids     []string
+		err     error
+		limit   int64
+		want    []string
+		wantErr bool
+	}{
+		"none": {},
+		"all": {
+			ids:  []string{"test-1", "test-2", "test-3"},
+			want: []string{"test-1", "test-2", "test-3"},
+		},
+		"limit": {
+			ids:   []string{"test-1", "test-2", "test-3"},
+			limit: 2,
+			want:  []string{"test-1", "test-2"},
+		},
+		"error": {
+			err:     errors.New("test error"),
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := &listResource{
+				identity: identity,
+				lister: &itypes.ServicePackageResourceLister{
+					List: func(context.Context, any) ([]string, error) {
+						return testCase.ids, testCase.err
+					},
+					TypeName: "aws_test",
+				},
+				meta: new(conns.AWSClient),
+			}
+			request := list.ListRequest{
+				Limit: testCase.limit,
+				ResourceSchema: rschema.Schema{
+					Attributes: map[string]rschema.Attribute{
+						names.AttrName: rschema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				ResourceIdentitySchema: newIdentitySchema(identity),
+			}
+			var stream list.ListResultsStream
+
+			l.List(ctx, request, &stream)
+
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					if !testCase.wantErr {
+						t.Fatalf("unexpected error: %v", result.Diagnostics)
+					}
+					return
+				}
+
+				var name types.String
+				if diags := result.Identity.GetAttribute(ctx, path.Root(names.AttrName), &name); diags.HasError() {
+					t.Fatalf("reading identity: %v", diags)
+				}
+				if got, want := name.ValueString(), result.DisplayName; got != want {
+					t.Errorf("identity %s = %q, want %q", names.AttrName, got, want)
+				}
+
+				got = append(got, result.DisplayName)
+			}
+
+			if testCase.wantErr {
+				t.Fatal("expected error")
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("results = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -143,6 +143,7 @@ type interceptedResponse interface {
 func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, c *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
+		ctx = conns.WithResourceOperation(ctx, resourceOperation(request))
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -195,3 +196,25 @@ func interceptedHandler[Request interceptedRequest, Response interceptedResponse
 		return diags
 	}
 }
+
+// resourceOperation returns the name of the CRUD operation for the specified request, e.g. "Create".
+func resourceOperation[Request interceptedRequest](request Request) string {
+	switch any(request).(type) {
+	case resource.CreateRequest:
+		return "Create"
+	case datasource.ReadRequest, resource.ReadRequest:
+		return "Read"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	case ephemeral.OpenRequest:
+		return "Open"
+	case ephemeral.RenewRequest:
+		return "Renew"
+	case ephemeral.CloseRequest:
+		return "Close"
+	default:
+		return ""
+	}
+}
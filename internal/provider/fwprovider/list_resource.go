@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// listResource implements `terraform query` listing for a resource that declares an identity.
+// Existing resources are enumerated by the resource lister registered by the resource's service package.
+type listResource struct {
+	identity types.Identity
+	lister   *types.ServicePackageResourceLister
+	meta     *conns.AWSClient
+	resource resource.ResourceWithConfigure
+}
+
+func newListResource(identity types.Identity, lister *types.ServicePackageResourceLister, resource resource.ResourceWithConfigure) list.ListResourceWithConfigure {
+	return &listResource{
+		identity: identity,
+		lister:   lister,
+		resource: resource,
+	}
+}
+
+func (l *listResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = l.lister.TypeName
+}
+
+func (l *listResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *listResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+
+	l.resource.Configure(ctx, request, response)
+}
+
+func (l *listResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ids, err := l.lister.List(ctx, l.meta)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(fmt.Sprintf("listing %s", l.lister.TypeName), err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, id := range ids {
+			if request.Limit > 0 && int64(i) >= request.Limit {
+				return
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = id
+
+			for name, value := range l.meta.IdentityValues(ctx, l.identity, id) {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+			}
+
+			if request.IncludeResource && !result.Diagnostics.HasError() {
+				state := tfsdk.State{
+					Raw:    tftypes.NewValue(request.ResourceSchema.Type().TerraformType(ctx), nil),
+					Schema: request.ResourceSchema,
+				}
+				state, diags := l.read(ctx, id, state)
+				result.Diagnostics.Append(diags...)
+				result.Resource.Raw = state.Raw
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// read returns the state of the resource with the specified import ID, as if it had been imported and refreshed.
+func (l *listResource) read(ctx context.Context, id string, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := l.resource.(resource.ResourceWithImportState)
+	if !ok {
+		return state, diags
+	}
+
+	importRequest := resource.ImportStateRequest{
+		ID: id,
+	}
+	importResponse := resource.ImportStateResponse{
+		State: state,
+	}
+	v.ImportState(ctx, importRequest, &importResponse)
+	diags.Append(importResponse.Diagnostics...)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	readRequest := resource.ReadRequest{
+		Private: importResponse.Private,
+		State:   importResponse.State,
+	}
+	readResponse := resource.ReadResponse{
+		Private: importResponse.Private,
+		State:   importResponse.State,
+	}
+	l.resource.Read(ctx, readRequest, &readResponse)
+	diags.Append(readResponse.Diagnostics...)
+
+	return readResponse.State, diags
+}
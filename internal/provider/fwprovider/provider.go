@@ -8,11 +8,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -61,6 +67,18 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON record of each AWS API call is appended. Can also be configured using the `TF_AWS_AUDIT_LOG_PATH` environment variable.",
+			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command to run to source credentials from an external process. Credentials are refreshed by re-running the command before they expire.",
+			},
+			"credentials_refresh_window": schema.StringAttribute{
+				Optional:    true,
+				Description: "Period before expiry in which temporary credentials are refreshed, for example `10m`. Defaults to `5m`. `0s` disables early refresh.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -101,6 +119,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to block all mutating AWS API calls, i.e. calls to operations other than Describe, Get, List and similar. Operations can be allowed per service using `read_only_allowed` blocks.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -177,42 +199,42 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 						"duration": schema.StringAttribute{
 							CustomType:  fwtypes.DurationType,
 							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							Description: tfsts.AssumeRoleDurationDescription,
 						},
 						"external_id": schema.StringAttribute{
 							Optional:    true,
-							Description: "A unique identifier that might be required when you assume a role in another account.",
+							Description: tfsts.AssumeRoleExternalIDDescription,
 						},
 						"policy": schema.StringAttribute{
 							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+							Description: tfsts.AssumeRolePolicyDescription,
 						},
 						"policy_arns": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+							Description: tfsts.AssumeRolePolicyARNsDescription,
 						},
 						"role_arn": schema.StringAttribute{
 							Optional:    true, // For historical reasons, we allow an empty `assume_role` block
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+							Description: tfsts.AssumeRoleRoleARNDescription,
 						},
 						"session_name": schema.StringAttribute{
 							Optional:    true,
-							Description: "An identifier for the assumed role session.",
+							Description: tfsts.AssumeRoleSessionNameDescription,
 						},
 						"source_identity": schema.StringAttribute{
 							Optional:    true,
-							Description: "Source identity specified by the principal assuming the role.",
+							Description: tfsts.AssumeRoleSourceIdentityDescription,
 						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Assume role session tags.",
+							Description: tfsts.AssumeRoleTagsDescription,
 						},
 						"transitive_tag_keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
+							Description: tfsts.AssumeRoleTransitiveTagKeysDescription,
 						},
 					},
 				},
@@ -226,24 +248,24 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 						"duration": schema.StringAttribute{
 							CustomType:  fwtypes.DurationType,
 							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							Description: tfsts.AssumeRoleDurationDescription,
 						},
 						"policy": schema.StringAttribute{
 							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+							Description: tfsts.AssumeRolePolicyDescription,
 						},
 						"policy_arns": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+							Description: tfsts.AssumeRolePolicyARNsDescription,
 						},
 						"role_arn": schema.StringAttribute{
 							Optional:    true, // For historical reasons, we allow an empty `assume_role_with_web_identity` block
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+							Description: tfsts.AssumeRoleRoleARNDescription,
 						},
 						"session_name": schema.StringAttribute{
 							Optional:    true,
-							Description: "An identifier for the assumed role session.",
+							Description: tfsts.AssumeRoleSessionNameDescription,
 						},
 						"web_identity_token": schema.StringAttribute{
 							Optional: true,
@@ -293,6 +315,108 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"read_only_allowed": schema.SetNestedBlock{
+				Description: "Configuration block with mutating AWS API operations that are allowed when `read_only` is set.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"operations": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The names of the allowed API operations, e.g. `AssumeRole`. If not set, all operations are allowed.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service name, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"service_concurrency": schema.SetNestedBlock{
+				Description: "Configuration block with settings to limit the number of in-flight mutating API calls per AWS service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+							Description: "The maximum number of in-flight mutating API calls to the service. 0 removes any default limit.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service name, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with organization rules that resource tags, including default tags, must satisfy.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[tftags.PolicyEnforcement](),
+							},
+							Description: "Whether violations are reported as errors or warnings. Valid values are `error` and `warning`. If not set, defaults to `error`.",
+						},
+						"exempt_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, or patterns such as `aws_iam_*`, to which the policy does not apply.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[tftags.PolicyCase](),
+							},
+							Description: "Letter case that all tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Rules for individual tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_pattern": schema.StringAttribute{
+										CustomType:  fwtypes.RegexpType,
+										Optional:    true,
+										Description: "Regular expression that the tag's value must match.",
+									},
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the tag is allowed to have.",
+									},
+									"exempt_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, or patterns such as `aws_iam_*`, to which the rule does not apply.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag must be set on all taggable resources.",
+									},
+									"value_case": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[tftags.PolicyCase](),
+										},
+										Description: "Letter case that the tag's value must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -351,13 +475,28 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				interceptors = append(interceptors, newTagsDataSourceInterceptor(v.Tags))
 			}
+			var regionOverride *regionOverride
+			if v.Region.IsRegionOverrideEnabled() {
+				// The data source's Region can be overridden.
+				// Inject the `region` argument unless the schema already defines one.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				regionOverride = newDataSourceRegionOverride(schemaResponse.Schema)
+			}
 
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
+					if regionOverride != nil {
+						ctx, diags = regionFromAttribute(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -366,8 +505,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 					return ctx, diags
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:   interceptors,
+				regionOverride: regionOverride,
+				typeName:       typeName,
 			}
 			dataSources = append(dataSources, func() datasource.DataSource {
 				return newWrappedDataSource(inner, opts)
@@ -431,16 +571,46 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					continue
 				}
 
-				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll)
+				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll, validateTagPolicy)
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
+			if v.Identity.HasIdentity() {
+				// The resource has declared an identity.
+				// Ensure that the identity attribute is defined.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[v.Identity.IdentityAttribute]; !ok {
+					errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", v.Identity.IdentityAttribute, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, newIdentityInterceptor(v.Identity))
+			}
+			var regionOverride *regionOverride
+			if v.Region.IsRegionOverrideEnabled() {
+				// The resource's Region can be overridden.
+				// Inject the `region` argument unless the schema already defines one.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if regionOverride = newResourceRegionOverride(schemaResponse.Schema); regionOverride != nil {
+					modifyPlanFuncs = append(modifyPlanFuncs, setRegionInPlan)
+				}
+			}
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
+					if regionOverride != nil {
+						ctx, diags = regionFromAttribute(ctx, getAttribute)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -449,8 +619,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 					return ctx, diags
 				},
+				identity:        v.Identity,
 				interceptors:    interceptors,
 				modifyPlanFuncs: modifyPlanFuncs,
+				regionOverride:  regionOverride,
 				typeName:        typeName,
 			}
 			resources = append(resources, func() resource.Resource {
@@ -468,6 +640,58 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// A List Resource is registered for each resource that declares an identity and
+// has a resource lister.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var listResources []func() list.ListResource
+
+	identities := make(map[string]itypes.Identity)
+	listers := make(map[string]*itypes.ServicePackageResourceLister)
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		data, ok := sp.(conns.ServicePackageWithResourceListers)
+		if !ok {
+			continue
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.Identity.HasIdentity() {
+				identities[v.TypeName] = v.Identity
+			}
+		}
+
+		for _, v := range data.ResourceListers(ctx) {
+			if _, ok := identities[v.TypeName]; ok {
+				listers[v.TypeName] = v
+			}
+		}
+	}
+
+	if len(listers) == 0 {
+		return listResources
+	}
+
+	for _, f := range p.Resources(ctx) {
+		var response resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{}, &response)
+
+		typeName := response.TypeName
+		lister, ok := listers[typeName]
+		if !ok {
+			continue
+		}
+
+		identity := identities[typeName]
+		listResources = append(listResources, func() list.ListResource {
+			return newListResource(identity, lister, f().(resource.ResourceWithConfigure))
+		})
+	}
+
+	return listResources
+}
+
 // EphemeralResources returns a slice of functions to instantiate each Ephemeral Resource
 // implementation.
 //
@@ -493,12 +717,27 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 				}
 
 				interceptors := ephemeralResourceInterceptors{}
+				var regionOverride *regionOverride
+				if v.Region.IsRegionOverrideEnabled() {
+					// The ephemeral resource's Region can be overridden.
+					// Inject the `region` argument unless the schema already defines one.
+					schemaResponse := ephemeral.SchemaResponse{}
+					inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+					regionOverride = newEphemeralResourceRegionOverride(schemaResponse.Schema)
+				}
 				opts := wrappedEphemeralResourceOptions{
 					// bootstrapContext is run on all wrapped methods before any interceptors.
-					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
+						if regionOverride != nil {
+							ctx, diags = regionFromAttribute(ctx, getAttribute)
+							if diags.HasError() {
+								return ctx, diags
+							}
+						}
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
@@ -506,8 +745,9 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 						}
 						return ctx, diags
 					},
-					interceptors: interceptors,
-					typeName:     v.TypeName,
+					interceptors:   interceptors,
+					regionOverride: regionOverride,
+					typeName:       v.TypeName,
 				}
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
 					return newWrappedEphemeralResource(inner, opts)
@@ -534,6 +774,10 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsPlanFunction,
+		tffunction.NewPolicyEqualFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+
+// regionOverride converts values between a schema with the injected `region` argument (outer)
+// and the wrapped resource's own schema (inner).
+// The wrapped resource only ever sees values conforming to its own schema.
+type regionOverride struct {
+	inner tfsdk.State // Holds the wrapped resource's schema.
+	outer tfsdk.State // Holds the schema with the `region` argument.
+}
+
+// newResourceRegionOverride returns a regionOverride for the specified resource schema.
+// nil is returned if the schema already defines a `region` attribute.
+func newResourceRegionOverride(inner rschema.Schema) *regionOverride {
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil
+	}
+
+	outer := inner
+	outer.Attributes = withResourceRegionAttribute(inner.Attributes)
+
+	return &regionOverride{
+		inner: tfsdk.State{Schema: inner},
+		outer: tfsdk.State{Schema: outer},
+	}
+}
+
+// newDataSourceRegionOverride returns a regionOverride for the specified data source schema.
+// nil is returned if the schema already defines a `region` attribute.
+func newDataSourceRegionOverride(inner dsschema.Schema) *regionOverride {
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil
+	}
+
+	outer := inner
+	outer.Attributes = withDataSourceRegionAttribute(inner.Attributes)
+
+	return &regionOverride{
+		inner: tfsdk.State{Schema: inner},
+		outer: tfsdk.State{Schema: outer},
+	}
+}
+
+// newEphemeralResourceRegionOverride returns a regionOverride for the specified ephemeral resource schema.
+// nil is returned if the schema already defines a `region` attribute.
+func newEphemeralResourceRegionOverride(inner ephemeralschema.Schema) *regionOverride {
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil
+	}
+
+	outer := inner
+	outer.Attributes = withEphemeralResourceRegionAttribute(inner.Attributes)
+
+	return &regionOverride{
+		inner: tfsdk.State{Schema: inner},
+		outer: tfsdk.State{Schema: outer},
+	}
+}
+
+func withResourceRegionAttribute(attributes map[string]rschema.Attribute) map[string]rschema.Attribute {
+	attributes = maps.Clone(attributes)
+	if attributes == nil {
+		attributes = make(map[string]rschema.Attribute)
+	}
+	attributes[names.AttrRegion] = rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators: []validator.String{
+			regionValidator{},
+		},
+	}
+
+	return attributes
+}
+
+func withDataSourceRegionAttribute(attributes map[string]dsschema.Attribute) map[string]dsschema.Attribute {
+	attributes = maps.Clone(attributes)
+	if attributes == nil {
+		attributes = make(map[string]dsschema.Attribute)
+	}
+	attributes[names.AttrRegion] = dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators: []validator.String{
+			regionValidator{},
+		},
+	}
+
+	return attributes
+}
+
+func withEphemeralResourceRegionAttribute(attributes map[string]ephemeralschema.Attribute) map[string]ephemeralschema.Attribute {
+	attributes = maps.Clone(attributes)
+	if attributes == nil {
+		attributes = make(map[string]ephemeralschema.Attribute)
+	}
+	attributes[names.AttrRegion] = ephemeralschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		Validators: []validator.String{
+			regionValidator{},
+		},
+	}
+
+	return attributes
+}
+
+// config returns the specified configuration without the `region` argument.
+func (o *regionOverride) config(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	return tfsdk.Config{
+		Raw:    o.remove(ctx, config.Raw, diags),
+		Schema: o.inner.Schema,
+	}
+}
+
+// plan returns the specified plan without the `region` argument.
+func (o *regionOverride) plan(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{
+		Raw:    o.remove(ctx, plan.Raw, diags),
+		Schema: o.inner.Schema,
+	}
+}
+
+// state returns the specified state without the `region` argument.
+func (o *regionOverride) state(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{
+		Raw:    o.remove(ctx, state.Raw, diags),
+		Schema: o.inner.Schema,
+	}
+}
+
+// result returns the specified ephemeral result without the `region` argument.
+func (o *regionOverride) result(ctx context.Context, result tfsdk.EphemeralResultData, diags *diag.Diagnostics) tfsdk.EphemeralResultData {
+	return tfsdk.EphemeralResultData{
+		Raw:    o.remove(ctx, result.Raw, diags),
+		Schema: o.inner.Schema,
+	}
+}
+
+// restorePlan returns the specified plan with the `region` argument set to the specified value.
+func (o *regionOverride) restorePlan(ctx context.Context, plan tfsdk.Plan, region tftypes.Value, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{
+		Raw:    o.add(ctx, plan.Raw, region, diags),
+		Schema: o.outer.Schema,
+	}
+}
+
+// restoreState returns the specified state with the `region` argument set to the specified value.
+func (o *regionOverride) restoreState(ctx context.Context, state tfsdk.State, region tftypes.Value, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{
+		Raw:    o.add(ctx, state.Raw, region, diags),
+		Schema: o.outer.Schema,
+	}
+}
+
+// restoreResult returns the specified ephemeral result with the `region` argument set to the specified value.
+func (o *regionOverride) restoreResult(ctx context.Context, result tfsdk.EphemeralResultData, region tftypes.Value, diags *diag.Diagnostics) tfsdk.EphemeralResultData {
+	return tfsdk.EphemeralResultData{
+		Raw:    o.add(ctx, result.Raw, region, diags),
+		Schema: o.outer.Schema,
+	}
+}
+
+// remove returns the specified object value without its `region` attribute.
+func (o *regionOverride) remove(ctx context.Context, v tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	typ := o.inner.Schema.Type().TerraformType(ctx)
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		diags.AddError("Removing Region", fmt.Sprintf("converting value: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+
+	delete(attributes, names.AttrRegion)
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+// add returns the specified object value with its `region` attribute set.
+func (o *regionOverride) add(ctx context.Context, v tftypes.Value, region tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	typ := o.outer.Schema.Type().TerraformType(ctx)
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		diags.AddError("Adding Region", fmt.Sprintf("converting value: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+// region returns the value of the `region` attribute of the specified object value.
+// A null value is returned if the object value is null or unknown.
+func (o *regionOverride) region(v tftypes.Value) tftypes.Value {
+	if v.IsNull() || !v.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		return v
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// removeRegionFromRawState returns the specified raw state without its `region` attribute, and the attribute's value.
+// A null value is returned if the raw state has no `region` attribute.
+func removeRegionFromRawState(rawState *tfprotov6.RawState, diags *diag.Diagnostics) (*tfprotov6.RawState, tftypes.Value) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if len(rawState.JSON) == 0 {
+		return rawState, region
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(rawState.JSON, &attributes); err != nil {
+		diags.AddError("Removing Region", fmt.Sprintf("decoding raw state: %s", err))
+		return rawState, region
+	}
+
+	v, ok := attributes[names.AttrRegion]
+	if !ok {
+		return rawState, region
+	}
+
+	var s *string
+	if err := json.Unmarshal(v, &s); err != nil {
+		diags.AddError("Removing Region", fmt.Sprintf("decoding raw state %s: %s", names.AttrRegion, err))
+		return rawState, region
+	}
+	if s != nil {
+		region = tftypes.NewValue(tftypes.String, *s)
+	}
+
+	delete(attributes, names.AttrRegion)
+
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		diags.AddError("Removing Region", fmt.Sprintf("encoding raw state: %s", err))
+		return rawState, region
+	}
+
+	return &tfprotov6.RawState{JSON: b, Flatmap: rawState.Flatmap}, region
+}
+
+// effectiveRegion returns the Region in which the resource is managed as a value.
+func effectiveRegion(ctx context.Context, c *conns.AWSClient) tftypes.Value {
+	if c == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, c.Region(ctx))
+}
+
+// regionFromAttribute returns a copy of the Context with the Region override set from the `region` attribute, if any.
+func regionFromAttribute(ctx context.Context, getAttribute getAttributeFunc) (context.Context, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if getAttribute == nil {
+		return ctx, diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		ctx = conns.WithOverrideRegion(ctx, v)
+	}
+
+	return ctx, diags
+}
+
+// regionFromConfigOrState returns a getAttributeFunc that reads values from the specified configuration.
+// A null `region` argument is read from the specified prior state if the stored Region is the provider's
+// default Region, so that an existing resource whose configuration omits the argument continues to be
+// managed in its current Region. If the provider's Region has changed, the null argument is returned and
+// the resource is planned in (and replaced into) the provider's new Region.
+func regionFromConfigOrState(config tfsdk.Config, state tfsdk.State, defaultRegion string) getAttributeFunc {
+	return func(ctx context.Context, p path.Path, target any) diag.Diagnostics {
+		if !p.Equal(path.Root(names.AttrRegion)) || state.Raw.IsNull() {
+			return config.GetAttribute(ctx, p, target)
+		}
+
+		var region types.String
+		if diags := config.GetAttribute(ctx, p, &region); diags.HasError() || !region.IsNull() {
+			return config.GetAttribute(ctx, p, target)
+		}
+
+		var stateRegion types.String
+		if diags := state.GetAttribute(ctx, p, &stateRegion); diags.HasError() || stateRegion.ValueString() != defaultRegion {
+			return config.GetAttribute(ctx, p, target)
+		}
+
+		return state.GetAttribute(ctx, p, target)
+	}
+}
+
+// privateRegionKey is the private state key under which an ephemeral resource's Region is stored
+// so that it is available to the Renew and Close methods, which have no access to configuration.
+const privateRegionKey = "region"
+
+// privateState is implemented by the Plugin Framework's provider-defined private state data.
+type privateState interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// setRegionInPrivate stores the specified Region in private state.
+func setRegionInPrivate(ctx context.Context, private privateState, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(region)
+	if err != nil {
+		diags.AddError("storing Region in private state", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, privateRegionKey, v)
+}
+
+// regionFromPrivate returns a copy of the Context with the Region override set from private state, if any.
+func regionFromPrivate(ctx context.Context, private privateState) (context.Context, diag.Diagnostics) {
+	v, diags := private.GetKey(ctx, privateRegionKey)
+	if diags.HasError() || len(v) == 0 {
+		return ctx, diags
+	}
+
+	var region string
+	if err := json.Unmarshal(v, &region); err != nil {
+		diags.AddError("reading Region from private state", err.Error())
+		return ctx, diags
+	}
+
+	if region != "" {
+		ctx = conns.WithOverrideRegion(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+// setRegionInPlan is a plan modifier that plans the Region in which the resource is managed.
+// A resource with no `region` argument is managed in the provider's configured Region,
+// which for an existing resource is its current Region unless the provider's Region has changed,
+// and a change to a resource's Region requires the resource to be replaced.
+func setRegionInPlan(ctx context.Context, c *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion, planRegion, stateRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if configRegion.IsUnknown() {
+		return
+	}
+
+	if configRegion.IsNull() {
+		// The Context's Region is the prior state's if that is still the provider's Region (see regionFromConfigOrState).
+		planRegion = types.StringValue(c.Region(ctx))
+
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if stateRegion.ValueString() != "" && !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+}
+
+// regionValidator validates that a string is a valid AWS Region name.
+type regionValidator struct{}
+
+func (v regionValidator) Description(context.Context) string {
+	return "value must be a valid AWS Region name"
+}
+
+func (v regionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := request.ConfigValue.ValueString(); !conns.IsValidRegionName(value) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid AWS Region",
+			fmt.Sprintf("%q is not a valid AWS Region name", value),
+		)
+	}
+}
@@ -0,0 +1,538 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRegionOverride(t *testing.T) *regionOverride {
+	t.Helper()
+
+	o := newResourceRegionOverride(rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			names.AttrID: rschema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: rschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	})
+	if o == nil {
+		t.Fatal("expected region override")
+	}
+
+	return o
+}
+
+func testRegionInnerValue(ctx context.Context, o *regionOverride, name string) tftypes.Value {
+	return tftypes.NewValue(o.inner.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		names.AttrID:   tftypes.NewValue(tftypes.String, "id"),
+		names.AttrName: tftypes.NewValue(tftypes.String, name),
+	})
+}
+
+func testRegionOuterValue(ctx context.Context, o *regionOverride, name string, region tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(o.outer.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		names.AttrID:     tftypes.NewValue(tftypes.String, "id"),
+		names.AttrName:   tftypes.NewValue(tftypes.String, name),
+		names.AttrRegion: region,
+	})
+}
+
+func testRegionContext(region string) context.Context {
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+
+	return conns.WithOverrideRegion(ctx, region)
+}
+
+func TestNewResourceRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	if o := newResourceRegionOverride(rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			names.AttrRegion: rschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}); o != nil {
+		t.Error("expected no region override for a schema with a region attribute")
+	}
+
+	o := testRegionOverride(t)
+	if _, ok := o.inner.Schema.GetAttributes()[names.AttrRegion]; ok {
+		t.Error("expected no region attribute in inner schema")
+	}
+	if _, ok := o.outer.Schema.GetAttributes()[names.AttrRegion]; !ok {
+		t.Error("expected region attribute in outer schema")
+	}
+}
+
+func TestRegionOverride_remove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	o := testRegionOverride(t)
+	innerType := o.inner.Schema.Type().TerraformType(ctx)
+	outerType := o.outer.Schema.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    tftypes.NewValue(outerType, nil),
+			expected: tftypes.NewValue(innerType, nil),
+		},
+		"unknown": {
+			value:    tftypes.NewValue(outerType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(innerType, tftypes.UnknownValue),
+		},
+		"known": {
+			value:    testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, "us-west-2")),
+			expected: testRegionInnerValue(ctx, o, "test"),
+		},
+		"known null region": {
+			value:    testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, nil)),
+			expected: testRegionInnerValue(ctx, o, "test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			got := o.remove(ctx, testCase.value, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegionOverride_add(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	o := testRegionOverride(t)
+	innerType := o.inner.Schema.Type().TerraformType(ctx)
+	outerType := o.outer.Schema.Type().TerraformType(ctx)
+	region := tftypes.NewValue(tftypes.String, "us-west-2")
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		region   tftypes.Value
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    tftypes.NewValue(innerType, nil),
+			region:   region,
+			expected: tftypes.NewValue(outerType, nil),
+		},
+		"unknown": {
+			value:    tftypes.NewValue(innerType, tftypes.UnknownValue),
+			region:   region,
+			expected: tftypes.NewValue(outerType, tftypes.UnknownValue),
+		},
+		"known": {
+			value:    testRegionInnerValue(ctx, o, "test"),
+			region:   region,
+			expected: testRegionOuterValue(ctx, o, "test", region),
+		},
+		"known null region": {
+			value:    testRegionInnerValue(ctx, o, "test"),
+			region:   tftypes.NewValue(tftypes.String, nil),
+			expected: testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, nil)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			got := o.add(ctx, testCase.value, testCase.region, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+			if got, want := o.region(got), testCase.region; got.IsKnown() && !got.IsNull() && !got.Equal(want) {
+				t.Errorf("expected region %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestSetRegionInPlan(t *testing.T) {
+	t.Parallel()
+
+	const providerRegion = "us-west-2"
+	ctx := testRegionContext(providerRegion)
+	o := testRegionOverride(t)
+	outerType := o.outer.Schema.Type().TerraformType(ctx)
+	null := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	testCases := map[string]struct {
+		configRegion    tftypes.Value
+		planRegion      tftypes.Value
+		state           tftypes.Value
+		expectedRegion  string
+		requiresReplace bool
+	}{
+		"new omitted": {
+			configRegion:   null,
+			planRegion:     unknown,
+			state:          tftypes.NewValue(outerType, nil),
+			expectedRegion: providerRegion,
+		},
+		"new": {
+			configRegion:   tftypes.NewValue(tftypes.String, "eu-west-1"),
+			planRegion:     tftypes.NewValue(tftypes.String, "eu-west-1"),
+			state:          tftypes.NewValue(outerType, nil),
+			expectedRegion: "eu-west-1",
+		},
+		"unchanged": {
+			configRegion:   tftypes.NewValue(tftypes.String, "eu-west-1"),
+			planRegion:     tftypes.NewValue(tftypes.String, "eu-west-1"),
+			state:          testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, "eu-west-1")),
+			expectedRegion: "eu-west-1",
+		},
+		"changed": {
+			configRegion:    tftypes.NewValue(tftypes.String, "eu-west-1"),
+			planRegion:      tftypes.NewValue(tftypes.String, "eu-west-1"),
+			state:           testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, "us-east-1")),
+			expectedRegion:  "eu-west-1",
+			requiresReplace: true,
+		},
+		"omitted": {
+			configRegion:   null,
+			planRegion:     tftypes.NewValue(tftypes.String, providerRegion),
+			state:          testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, providerRegion)),
+			expectedRegion: providerRegion,
+		},
+		"omitted provider region changed": {
+			configRegion:    null,
+			planRegion:      tftypes.NewValue(tftypes.String, "us-east-1"),
+			state:           testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, "us-east-1")),
+			expectedRegion:  providerRegion,
+			requiresReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Raw:    testRegionOuterValue(ctx, o, "test", testCase.planRegion),
+				Schema: o.outer.Schema,
+			}
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testRegionOuterValue(ctx, o, "test", testCase.configRegion),
+					Schema: o.outer.Schema,
+				},
+				Plan: plan,
+				State: tfsdk.State{
+					Raw:    testCase.state,
+					Schema: o.outer.Schema,
+				},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: plan,
+			}
+
+			setRegionInPlan(ctx, new(conns.AWSClient), request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var region types.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := region.ValueString(), testCase.expectedRegion; got != want {
+				t.Errorf("expected region %q, got %q", want, got)
+			}
+			if got, want := len(response.RequiresReplace) > 0, testCase.requiresReplace; got != want {
+				t.Errorf("expected requires replace %t, got %t", want, got)
+			}
+		})
+	}
+}
+
+func TestRegionFromConfigOrState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	o := testRegionOverride(t)
+	outerType := o.outer.Schema.Type().TerraformType(ctx)
+
+	const providerRegion = "us-east-1"
+
+	testCases := map[string]struct {
+		configRegion tftypes.Value
+		state        tftypes.Value
+		expected     string
+	}{
+		"config": {
+			configRegion: tftypes.NewValue(tftypes.String, "eu-west-1"),
+			state:        testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, providerRegion)),
+			expected:     "eu-west-1",
+		},
+		"state": {
+			configRegion: tftypes.NewValue(tftypes.String, nil),
+			state:        testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, providerRegion)),
+			expected:     providerRegion,
+		},
+		"state provider region changed": {
+			configRegion: tftypes.NewValue(tftypes.String, nil),
+			state:        testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, "eu-west-1")),
+		},
+		"new": {
+			configRegion: tftypes.NewValue(tftypes.String, nil),
+			state:        tftypes.NewValue(outerType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getAttribute := regionFromConfigOrState(
+				tfsdk.Config{Raw: testRegionOuterValue(ctx, o, "test", testCase.configRegion), Schema: o.outer.Schema},
+				tfsdk.State{Raw: testCase.state, Schema: o.outer.Schema},
+				providerRegion,
+			)
+
+			var region types.String
+			if diags := getAttribute(ctx, path.Root(names.AttrRegion), &region); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := region.ValueString(), testCase.expected; got != want {
+				t.Errorf("expected region %q, got %q", want, got)
+			}
+
+			var name types.String
+			if diags := getAttribute(ctx, path.Root(names.AttrName), &name); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := name.ValueString(), "test"; got != want {
+				t.Errorf("expected name %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+// TestModifyPlanRegionOmitted plans an existing resource whose configuration omits the `region` argument
+// as the wrapped resource's ModifyPlan does: the Region override is set from configuration or prior state
+// and then the Region is planned.
+func TestModifyPlanRegionOmitted(t *testing.T) {
+	t.Parallel()
+
+	const stateRegion = "us-east-1"
+	o := testRegionOverride(t)
+
+	testCases := map[string]struct {
+		providerRegion  string
+		expectedRegion  string
+		requiresReplace bool
+	}{
+		"provider region unchanged": {
+			providerRegion: stateRegion,
+			expectedRegion: stateRegion,
+		},
+		"provider region changed": {
+			providerRegion:  "eu-west-1",
+			expectedRegion:  "eu-west-1",
+			requiresReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The provider's Region is set as an override as new(conns.AWSClient) has no Region.
+			ctx := testRegionContext(testCase.providerRegion)
+			state := testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, stateRegion))
+			plan := tfsdk.Plan{
+				Raw:    state,
+				Schema: o.outer.Schema,
+			}
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testRegionOuterValue(ctx, o, "test", tftypes.NewValue(tftypes.String, nil)),
+					Schema: o.outer.Schema,
+				},
+				Plan: plan,
+				State: tfsdk.State{
+					Raw:    state,
+					Schema: o.outer.Schema,
+				},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: plan,
+			}
+
+			ctx, diags := regionFromAttribute(ctx, regionFromConfigOrState(request.Config, request.State, testCase.providerRegion))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			setRegionInPlan(ctx, new(conns.AWSClient), request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var region types.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := region.ValueString(), testCase.expectedRegion; got != want {
+				t.Errorf("expected region %q, got %q", want, got)
+			}
+			if got, want := len(response.RequiresReplace) > 0, testCase.requiresReplace; got != want {
+				t.Errorf("expected requires replace %t, got %t", want, got)
+			}
+		})
+	}
+}
+
+func TestUpgradeStateWithRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	o := testRegionOverride(t)
+	innerType := o.inner.Schema.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		upgrader       resource.StateUpgrader
+		rawState       string
+		expectedRegion tftypes.Value
+	}{
+		"nil PriorSchema DynamicValue": {
+			upgrader: resource.StateUpgrader{
+				StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					v, err := request.RawState.Unmarshal(innerType)
+					if err != nil {
+						response.Diagnostics.AddError("unmarshaling raw state", err.Error())
+						return
+					}
+
+					dv, err := tfprotov6.NewDynamicValue(innerType, v)
+					if err != nil {
+						response.Diagnostics.AddError("creating dynamic value", err.Error())
+						return
+					}
+					response.DynamicValue = &dv
+				},
+			},
+			rawState:       `{"id":"id","name":"test","region":"eu-west-1"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"),
+		},
+		"nil PriorSchema State": {
+			upgrader: resource.StateUpgrader{
+				StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					v, err := request.RawState.Unmarshal(innerType)
+					if err != nil {
+						response.Diagnostics.AddError("unmarshaling raw state", err.Error())
+						return
+					}
+
+					response.State.Raw = v
+				},
+			},
+			rawState:       `{"id":"id","name":"test"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		"PriorSchema": {
+			upgrader: resource.StateUpgrader{
+				PriorSchema: &rschema.Schema{
+					Attributes: map[string]rschema.Attribute{
+						names.AttrID: rschema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: rschema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					response.State.Raw = request.State.Raw
+				},
+			},
+			rawState:       `{"id":"id","name":"test","region":"eu-west-1"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &wrappedResource{
+				opts: wrappedResourceOptions{
+					regionOverride: o,
+				},
+			}
+			upgrader := w.upgradeStateWithRegion(map[int64]resource.StateUpgrader{0: testCase.upgrader})[0]
+
+			request := resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.rawState)},
+			}
+			if upgrader.PriorSchema != nil {
+				v, err := request.RawState.Unmarshal(upgrader.PriorSchema.Type().TerraformType(ctx))
+				if err != nil {
+					t.Fatalf("unmarshaling raw state with prior schema: %s", err)
+				}
+				request.State = &tfsdk.State{Raw: v, Schema: *upgrader.PriorSchema}
+			}
+			response := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: o.outer.Schema},
+			}
+
+			upgrader.StateUpgrader(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+			if response.DynamicValue != nil {
+				t.Fatal("expected DynamicValue to be converted to State")
+			}
+
+			if expected := testRegionOuterValue(ctx, o, "test", testCase.expectedRegion); !response.State.Raw.Equal(expected) {
+				t.Errorf("expected %s, got %s", expected, response.State.Raw)
+			}
+		})
+	}
+}
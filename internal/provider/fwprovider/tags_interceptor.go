@@ -292,3 +292,44 @@ func setTagsAll(ctx context.Context, meta *conns.AWSClient, request resource.Mod
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 	}
 }
+
+// validateTagPolicy is a plan modifier that checks the resource's planned tags, including any provider
+// configured default_tags, against any provider configured tag_policy.
+func validateTagPolicy(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	tagPolicyConfig := meta.TagPolicyConfig(ctx)
+	if tagPolicyConfig == nil {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var planTags tftags.Map
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags that aren't yet known are checked when the plan is finalized during apply.
+	if !planTags.IsWhollyKnown() {
+		return
+	}
+
+	tags := meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName())
+
+	for _, violation := range tagPolicyConfig.Violations(inContext.TypeName(), tags) {
+		if tagPolicyConfig.IsWarning() {
+			response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag policy violation", fmt.Sprintf("%s: %s", inContext.TypeName(), violation))
+		} else {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", fmt.Sprintf("%s: %s", inContext.TypeName(), violation))
+		}
+	}
+}
@@ -5,15 +5,20 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // Implemented by (Config|Plan|State).GetAttribute().
@@ -26,7 +31,9 @@ type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     dataSourceInterceptors
-	typeName         string
+	// regionOverride is non-nil if the `region` argument is injected into the data source's schema.
+	regionOverride *regionOverride
+	typeName       string
 }
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
@@ -56,6 +63,10 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.regionOverride != nil {
+		response.Schema.Attributes = withDataSourceRegionAttribute(response.Schema.Attributes)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -66,7 +77,19 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Read(ctx, request, response)
+
+			response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -112,6 +135,13 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 			return
 		}
 
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -120,7 +150,9 @@ type wrappedEphemeralResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     ephemeralResourceInterceptors
-	typeName         string
+	// regionOverride is non-nil if the `region` argument is injected into the ephemeral resource's schema.
+	regionOverride *regionOverride
+	typeName       string
 }
 
 // wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
@@ -150,6 +182,10 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.regionOverride != nil {
+		response.Schema.Attributes = withEphemeralResourceRegionAttribute(response.Schema.Attributes)
+	}
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
@@ -160,7 +196,22 @@ func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.O
 	}
 
 	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
-		w.inner.Open(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			response.Result = o.result(ctx, response.Result, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Open(ctx, request, response)
+
+			response.Result = o.restoreResult(ctx, response.Result, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+			if response.Private != nil {
+				response.Diagnostics.Append(setRegionInPrivate(ctx, response.Private, w.meta.Region(ctx))...)
+			}
+		} else {
+			w.inner.Open(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.open(), f, w.meta)(ctx, request, response)...)
@@ -188,6 +239,14 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 			return
 		}
 
+		if w.opts.regionOverride != nil && request.Private != nil {
+			ctx, diags = regionFromPrivate(ctx, request.Private)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		f := func(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) diag.Diagnostics {
 			v.Renew(ctx, request, response)
 			return response.Diagnostics
@@ -204,6 +263,14 @@ func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.
 			return
 		}
 
+		if w.opts.regionOverride != nil && request.Private != nil {
+			ctx, diags = regionFromPrivate(ctx, request.Private)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		f := func(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) diag.Diagnostics {
 			v.Close(ctx, request, response)
 			return response.Diagnostics
@@ -238,6 +305,13 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 			return
 		}
 
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -248,9 +322,12 @@ type modifyPlanFunc func(context.Context, *conns.AWSClient, resource.ModifyPlanR
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
+	identity         types.Identity
 	interceptors     resourceInterceptors
 	modifyPlanFuncs  []modifyPlanFunc
-	typeName         string
+	// regionOverride is non-nil if the `region` argument is injected into the resource's schema.
+	regionOverride *regionOverride
+	typeName       string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
@@ -260,11 +337,29 @@ type wrappedResource struct {
 	opts  wrappedResourceOptions
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that declares an identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	// This method does not call down to the inner resource.
+	response.IdentitySchema = newIdentitySchema(w.opts.identity)
+}
+
 func newWrappedResource(inner resource.ResourceWithConfigure, opts wrappedResourceOptions) resource.ResourceWithConfigure {
-	return &wrappedResource{
+	w := &wrappedResource{
 		inner: inner,
 		opts:  opts,
 	}
+
+	if opts.identity.HasIdentity() {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -280,6 +375,10 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.regionOverride != nil {
+		response.Schema.Attributes = withResourceRegionAttribute(response.Schema.Attributes)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -290,7 +389,20 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 	}
 
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			request.Plan = o.plan(ctx, request.Plan, &response.Diagnostics)
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Create(ctx, request, response)
+
+			response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		} else {
+			w.inner.Create(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.create(), f, w.meta)(ctx, request, response)...)
@@ -304,7 +416,19 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 	}
 
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.State = o.state(ctx, request.State, &response.Diagnostics)
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Read(ctx, request, response)
+
+			response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -318,7 +442,21 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 	}
 
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			request.Plan = o.plan(ctx, request.Plan, &response.Diagnostics)
+			request.State = o.state(ctx, request.State, &response.Diagnostics)
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Update(ctx, request, response)
+
+			response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		} else {
+			w.inner.Update(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.update(), f, w.meta)(ctx, request, response)...)
@@ -332,7 +470,19 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 	}
 
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			request.State = o.state(ctx, request.State, &response.Diagnostics)
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return response.Diagnostics
+			}
+
+			w.inner.Delete(ctx, request, response)
+
+			response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		} else {
+			w.inner.Delete(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.delete(), f, w.meta)(ctx, request, response)...)
@@ -353,6 +503,23 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// The resource is imported by identity rather than by ID.
+	if w.opts.identity.HasIdentity() && request.ID == "" && request.Identity != nil {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		id, diags := importIDFromIdentity(ctx, w.meta, w.opts.identity, w.opts.regionOverride != nil, request)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		request.ID = id
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
 		response.Diagnostics.Append(diags...)
@@ -360,7 +527,25 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 			return
 		}
 
-		v.ImportState(ctx, request, response)
+		w.importState(ctx, v.ImportState, request, response)
+
+		return
+	}
+
+	if w.opts.identity.HasIdentity() {
+		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if w.opts.regionOverride != nil {
+			ctx = withRegionFromARNImportID(ctx, w.opts.identity, request.ID)
+		}
+
+		w.importState(ctx, func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+			importStateByIdentity(ctx, w.meta, w.opts.identity, request, response)
+		}, request, response)
 
 		return
 	}
@@ -371,8 +556,41 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// importState calls the specified import function.
+// If the `region` argument is injected, an import ID with a Region suffix (`id@region`) imports the resource from that Region.
+func (w *wrappedResource) importState(ctx context.Context, f func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse), request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	o := w.opts.regionOverride
+	if o == nil {
+		f(ctx, request, response)
+
+		return
+	}
+
+	if id, region := conns.SplitImportIDRegion(request.ID); region != "" {
+		ctx = conns.WithOverrideRegion(ctx, region)
+		request.ID = id
+	}
+
+	response.State = o.state(ctx, response.State, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	f(ctx, request, response)
+
+	response.State = o.restoreState(ctx, response.State, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	getAttribute := request.Config.GetAttribute
+	if w.opts.regionOverride != nil {
+		var defaultRegion string
+		if w.meta != nil {
+			defaultRegion = w.meta.Region(ctx)
+		}
+		getAttribute = regionFromConfigOrState(request.Config, request.State, defaultRegion)
+	}
+	ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
@@ -386,7 +604,23 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	}
 
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		v.ModifyPlan(ctx, request, response)
+		if o := w.opts.regionOverride; o != nil {
+			region := o.region(response.Plan.Raw)
+
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			request.Plan = o.plan(ctx, request.Plan, &response.Diagnostics)
+			request.State = o.state(ctx, request.State, &response.Diagnostics)
+			response.Plan = o.plan(ctx, response.Plan, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			v.ModifyPlan(ctx, request, response)
+
+			response.Plan = o.restorePlan(ctx, response.Plan, region, &response.Diagnostics)
+		} else {
+			v.ModifyPlan(ctx, request, response)
+		}
 	}
 }
 
@@ -416,6 +650,13 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			return
 		}
 
+		if o := w.opts.regionOverride; o != nil {
+			request.Config = o.config(ctx, request.Config, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -432,7 +673,12 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 			return nil
 		}
 
-		return v.UpgradeState(ctx)
+		upgraders := v.UpgradeState(ctx)
+		if w.opts.regionOverride != nil {
+			upgraders = w.upgradeStateWithRegion(upgraders)
+		}
+
+		return upgraders
 	}
 
 	return nil
@@ -450,8 +696,95 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 			return nil
 		}
 
-		return v.MoveState(ctx)
+		movers := v.MoveState(ctx)
+		if w.opts.regionOverride != nil {
+			movers = w.moveStateWithRegion(movers)
+		}
+
+		return movers
 	}
 
 	return nil
 }
+
+// upgradeStateWithRegion wraps the specified state upgraders so that the wrapped resource only sees values without the `region` argument.
+// The prior Region, if any, is retained in the upgraded state.
+func (w *wrappedResource) upgradeStateWithRegion(upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	o := w.opts.regionOverride
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for version, upgrader := range upgraders {
+		var prior *regionOverride
+		if upgrader.PriorSchema != nil {
+			prior = newResourceRegionOverride(*upgrader.PriorSchema)
+			if prior != nil {
+				priorSchema := prior.outer.Schema.(rschema.Schema)
+				upgrader.PriorSchema = &priorSchema
+			}
+		}
+
+		f := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			region := tftypes.NewValue(tftypes.String, nil)
+			if prior != nil && request.State != nil {
+				region = prior.region(request.State.Raw)
+				state := prior.state(ctx, *request.State, &response.Diagnostics)
+				request.State = &state
+			} else if request.RawState != nil {
+				// Without a prior schema the wrapped resource upgrades the raw state itself.
+				request.RawState, region = removeRegionFromRawState(request.RawState, &response.Diagnostics)
+			}
+			response.State = o.state(ctx, response.State, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			f(ctx, request, response)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			// Upgraded state returned as a DynamicValue conforms to the wrapped resource's schema.
+			if response.DynamicValue != nil {
+				v, err := response.DynamicValue.Unmarshal(o.inner.Schema.Type().TerraformType(ctx))
+				if err != nil {
+					response.Diagnostics.AddError("Upgrading Resource State", fmt.Sprintf("decoding upgraded state: %s", err))
+					return
+				}
+
+				response.DynamicValue = nil
+				response.State = tfsdk.State{Raw: v, Schema: o.inner.Schema}
+			}
+
+			response.State = o.restoreState(ctx, response.State, region, &response.Diagnostics)
+		}
+
+		wrapped[version] = upgrader
+	}
+
+	return wrapped
+}
+
+// moveStateWithRegion wraps the specified state movers so that the wrapped resource only sees target state without the `region` argument.
+func (w *wrappedResource) moveStateWithRegion(movers []resource.StateMover) []resource.StateMover {
+	o := w.opts.regionOverride
+	wrapped := make([]resource.StateMover, 0, len(movers))
+
+	for _, mover := range movers {
+		f := mover.StateMover
+		mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			response.TargetState = o.state(ctx, response.TargetState, &response.Diagnostics)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			f(ctx, request, response)
+
+			response.TargetState = o.restoreState(ctx, response.TargetState, effectiveRegion(ctx, w.meta), &response.Diagnostics)
+		}
+
+		wrapped = append(wrapped, mover)
+	}
+
+	return wrapped
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newResourceIdentity returns the Plugin SDK v2 identity schema for the specified resource identity.
+func newResourceIdentity(identity types.Identity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(identity.Attributes))
+
+			for _, v := range identity.Attributes {
+				s[v.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: v.Required,
+					OptionalForImport: !v.Required,
+				}
+			}
+
+			return s
+		},
+	}
+}
+
+// setIdentityInState returns an interceptor that sets the resource's identity from its state.
+func setIdentityInState(identity types.Identity) interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		d, ok := opts.d.(*schema.ResourceData)
+		if !ok {
+			return diags
+		}
+
+		// The resource has been deleted or not found.
+		if d.Id() == "" {
+			return diags
+		}
+
+		id := d.Id()
+		if identity.IdentityAttribute != names.AttrID {
+			id = d.Get(identity.IdentityAttribute).(string)
+		}
+
+		v, err := d.Identity()
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		for name, value := range opts.c.IdentityValues(ctx, identity, id) {
+			if err := v.Set(name, value); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting identity %s: %s", name, err)
+			}
+		}
+
+		return diags
+	})
+}
+
+// importIDFromIdentity sets the import ID of a resource imported by identity rather than by ID.
+func importIDFromIdentity(ctx context.Context, d *schema.ResourceData, meta any, identity types.Identity, isRegionOverrideEnabled bool) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	v, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(identity.Attributes))
+	for _, attr := range identity.Attributes {
+		if v, ok := v.GetOk(attr.Name); ok {
+			values[attr.Name] = v.(string)
+		}
+	}
+
+	id, err := meta.(*conns.AWSClient).ImportIDFromIdentity(ctx, identity, values, isRegionOverrideEnabled)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// newIdentityImporter returns an importer for a resource that declares an identity but has no importer.
+// The import ID is the value of the resource's identity attribute.
+// If the resource's Region can be overridden, an ARN's Region is used as the resource's Region.
+func newIdentityImporter(identity types.Identity, isRegionOverrideEnabled bool) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			if identity.IsARN {
+				v, err := arn.Parse(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("import ID (%s) is not a valid ARN: %w", d.Id(), err)
+				}
+
+				if c, ok := meta.(*conns.AWSClient); ok && !identity.IsGlobalResource {
+					if region := c.Region(ctx); v.Region != region {
+						if !isRegionOverrideEnabled {
+							return nil, fmt.Errorf("import ID's Region (%s) does not match the provider's configured Region (%s)", v.Region, region)
+						}
+
+						if err := d.Set(names.AttrRegion, v.Region); err != nil {
+							return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+						}
+					}
+				}
+			}
+
+			if identity.IdentityAttribute != names.AttrID {
+				if err := d.Set(identity.IdentityAttribute, d.Id()); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", identity.IdentityAttribute, err)
+				}
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIdentityImporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrARN: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		identity      types.Identity
+		id            string
+		wantAttribute string
+		wantErr       bool
+	}{
+		"single parameter": {
+			identity:      types.RegionalSingleParameterIdentity(names.AttrName),
+			id:            "test-name",
+			wantAttribute: names.AttrName,
+		},
+		"ARN": {
+			identity:      types.RegionalARNIdentity(names.AttrARN),
+			id:            "arn:aws:logs:us-west-2:123456789012:anomaly-detector:abcdef", //lintignore:AWSAT003,AWSAT005
+			wantAttribute: names.AttrARN,
+		},
+		"invalid ARN": {
+			identity: types.RegionalARNIdentity(names.AttrARN),
+			id:       "test-name",
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{})
+			d.SetId(testCase.id)
+
+			got, err := newIdentityImporter(testCase.identity, false).StateContext(ctx, d, nil)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(got))
+			}
+
+			if got, want := got[0].Get(testCase.wantAttribute).(string), testCase.id; got != want {
+				t.Errorf("%s = %q, want %q", testCase.wantAttribute, got, want)
+			}
+		})
+	}
+}
+
+func TestNewResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	identitySchema := newResourceIdentity(types.RegionalSingleParameterIdentity(names.AttrName)).SchemaFunc()
+
+	testCases := map[string]struct {
+		attribute string
+		required  bool
+	}{
+		names.AttrAccountID: {
+			attribute: names.AttrAccountID,
+		},
+		names.AttrName: {
+			attribute: names.AttrName,
+			required:  true,
+		},
+		names.AttrRegion: {
+			attribute: names.AttrRegion,
+		},
+	}
+
+	if got, want := len(identitySchema), len(testCases); got != want {
+		t.Fatalf("expected %d identity attributes, got %d", want, got)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := identitySchema[testCase.attribute]
+			if !ok {
+				t.Fatalf("expected %s identity attribute", testCase.attribute)
+			}
+
+			if got, want := v.RequiredForImport, testCase.required; got != want {
+				t.Errorf("RequiredForImport = %t, want %t", got, want)
+			}
+			if got, want := v.OptionalForImport, !testCase.required; got != want {
+				t.Errorf("OptionalForImport = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSetIdentityInState(t *testing.T) {
+	t.Parallel()
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	ctx = conns.WithOverrideRegion(ctx, "us-west-2") //lintignore:AWSAT003
+	identity := types.RegionalSingleParameterIdentity(names.AttrName)
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resourceSchema, newResourceIdentity(identity).SchemaFunc(), map[string]string{})
+	d.SetId("test-name")
+	if err := d.Set(names.AttrName, "test-name"); err != nil {
+		t.Fatalf("setting %s: %s", names.AttrName, err)
+	}
+
+	opts := interceptorOptions{
+		c:    new(conns.AWSClient),
+		d:    d,
+		when: After,
+		why:  Create,
+	}
+	if diags := setIdentityInState(identity).run(ctx, opts); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	v, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := v.Get(names.AttrName).(string), "test-name"; got != want {
+		t.Errorf("identity %s = %q, want %q", names.AttrName, got, want)
+	}
+	if got, want := v.Get(names.AttrRegion).(string), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("identity %s = %q, want %q", names.AttrRegion, got, want)
+	}
+}
+
+func TestImportIDFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+	ctx = conns.WithOverrideRegion(ctx, "us-west-2") //lintignore:AWSAT003
+	identity := types.RegionalSingleParameterIdentity(names.AttrName)
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		id                      string
+		identity                map[string]string
+		isRegionOverrideEnabled bool
+		wantID                  string
+		wantErr                 bool
+	}{
+		"import ID": {
+			id:     "test-name",
+			wantID: "test-name",
+		},
+		"identity": {
+			identity: map[string]string{
+				names.AttrName: "test-name",
+			},
+			wantID: "test-name",
+		},
+		"identity in different Region": {
+			identity: map[string]string{
+				names.AttrName:   "test-name",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantErr: true,
+		},
+		"identity in different Region with Region override": {
+			identity: map[string]string{
+				names.AttrName:   "test-name",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			isRegionOverrideEnabled: true,
+			wantID:                  "test-name@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataWithIdentityRaw(t, resourceSchema, newResourceIdentity(identity).SchemaFunc(), testCase.identity)
+			d.SetId(testCase.id)
+
+			err := importIDFromIdentity(ctx, d, new(conns.AWSClient), identity, testCase.isRegionOverrideEnabled)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Id(), testCase.wantID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -71,6 +71,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// String returns the name of a single CRUD operation, e.g. "Create".
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -87,6 +103,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		if diags.HasError() {
 			return diags
 		}
+		ctx = conns.WithResourceOperation(ctx, why.String())
 
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
@@ -10,10 +10,11 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -23,10 +24,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -55,6 +58,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON record of each AWS API call is appended. " +
+					"Can also be configured using the `TF_AWS_AUDIT_LOG_PATH` environment variable.",
+			},
+			"credential_process": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_key", "assume_role", "assume_role_with_web_identity", "secret_key", "token"},
+				Description: "Command to run to source credentials from an external process. " +
+					"Credentials are refreshed by re-running the command before they expire.",
+			},
+			"credentials_refresh_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				Description: "Period before expiry in which temporary credentials are refreshed, for example `10m`. " +
+					"Defaults to `5m`. `0s` disables early refresh.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -159,6 +182,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to block all mutating AWS API calls, i.e. calls to operations other than Describe, Get, List and similar. " +
+					"Operations can be allowed per service using `read_only_allowed` blocks.",
+			},
+			"read_only_allowed": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block with mutating AWS API operations that are allowed when `read_only` is set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operations": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the allowed API operations, e.g. `AssumeRole`. If not set, all operations are allowed.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service name, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -192,6 +241,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the number of in-flight mutating API calls per AWS service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "The maximum number of in-flight mutating API calls to the service. 0 removes any default limit.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service name, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -235,6 +304,77 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with organization rules that resource tags, including default tags, must satisfy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.PolicyEnforcement](),
+							Description:      "Whether violations are reported as errors or warnings. Valid values are `error` and `warning`. If not set, defaults to `error`.",
+						},
+						"exempt_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, or patterns such as `aws_iam_*`, to which the policy does not apply.",
+						},
+						"key_case": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.PolicyCase](),
+							Description:      "Letter case that all tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules for individual tag keys.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the tag's value must match.",
+									},
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values that the tag is allowed to have.",
+									},
+									"exempt_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, or patterns such as `aws_iam_*`, to which the rule does not apply.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag must be set on all taggable resources.",
+									},
+									"value_case": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[tftags.PolicyCase](),
+										Description:      "Letter case that the tag's value must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -315,11 +455,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			var isRegionOverrideEnabled bool
+			if v.Region.IsRegionOverrideEnabled() && injectRegionSchema(r, false) {
+				isRegionOverrideEnabled = true
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: setRegionInState(),
+				})
+			}
+
 			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
+					if isRegionOverrideEnabled {
+						ctx = regionFromAttribute(ctx, getAttribute)
+					}
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -388,7 +541,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
-				customizeDiffFuncs = append(customizeDiffFuncs, setTagsAll)
+				customizeDiffFuncs = append(customizeDiffFuncs, setTagsAll, validateTagPolicy)
 				interceptors = append(interceptors, interceptorItem{
 					when:        Before | After | Finally,
 					why:         Create | Read | Update,
@@ -396,12 +549,46 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			var isRegionOverrideEnabled bool
+			if v.Region.IsRegionOverrideEnabled() && injectRegionSchema(r, true) {
+				isRegionOverrideEnabled = true
+				customizeDiffFuncs = append(customizeDiffFuncs, setRegionInPlan)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: setRegionInState(),
+				})
+			}
+
+			if v.Identity.HasIdentity() {
+				// The resource has declared an identity.
+				// Ensure that the identity attribute is defined.
+				if _, ok := r.SchemaMap()[v.Identity.IdentityAttribute]; !ok && v.Identity.IdentityAttribute != names.AttrID {
+					errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", v.Identity.IdentityAttribute, typeName))
+					continue
+				}
+
+				if r.Importer == nil {
+					r.Importer = newIdentityImporter(v.Identity, isRegionOverrideEnabled)
+				}
+
+				r.Identity = newResourceIdentity(v.Identity)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: setIdentityInState(v.Identity),
+				})
+			}
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
+					if isRegionOverrideEnabled {
+						ctx = regionFromAttribute(ctx, getAttribute)
+					}
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -409,9 +596,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 					return ctx, diags
 				},
-				customizeDiffFuncs: customizeDiffFuncs,
-				interceptors:       interceptors,
-				typeName:           typeName,
+				customizeDiffFuncs:      customizeDiffFuncs,
+				identity:                v.Identity,
+				interceptors:            interceptors,
+				isRegionOverrideEnabled: isRegionOverrideEnabled,
+				typeName:                typeName,
 			}
 			wrapResource(r, opts)
 			provider.ResourcesMap[typeName] = r
@@ -450,6 +639,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
+		CredentialProcess:              d.Get("credential_process").(string),
+		CredentialsRefreshWindow:       conns.DefaultCredentialsRefreshWindow, // Set default here, not in schema (muxing with v6 provider).
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -457,6 +649,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -471,6 +664,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.Get("credentials_refresh_window").(string); ok && v != "" {
+		window, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.CredentialsRefreshWindow = window
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -567,10 +768,37 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicyConfig, dx := expandTagPolicy(ctx, v.([]any)[0].(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("read_only_allowed"); ok && v.(*schema.Set).Len() > 0 {
+		readOnlyAllowed, dx := expandReadOnlyAllowed(ctx, v.(*schema.Set).List())
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ReadOnlyAllowed = readOnlyAllowed
+	}
+
+	if v, ok := d.GetOk("service_concurrency"); ok && v.(*schema.Set).Len() > 0 {
+		serviceConcurrency, dx := expandServiceConcurrency(ctx, v.(*schema.Set).List())
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceConcurrency = serviceConcurrency
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -612,28 +840,28 @@ func assumeRoleSchema() *schema.Schema {
 				"duration": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					Description:  tfsts.AssumeRoleDurationDescription,
 					ValidateFunc: validAssumeRoleDuration,
 				},
 				"external_id": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "A unique identifier that might be required when you assume a role in another account.",
+					Description: tfsts.AssumeRoleExternalIDDescription,
 					ValidateFunc: validation.All(
-						validation.StringLenBetween(2, 1224),
-						validation.StringMatch(regexache.MustCompile(`[\w+=,.@:\/\-]*`), ""),
+						validation.StringLenBetween(tfsts.AssumeRoleExternalIDMinLength, tfsts.AssumeRoleExternalIDMaxLength),
+						validation.StringMatch(tfsts.AssumeRoleExternalIDRegexp, ""),
 					),
 				},
 				"policy": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					Description:  tfsts.AssumeRolePolicyDescription,
 					ValidateFunc: validation.StringIsJSON,
 				},
 				"policy_arns": {
 					Type:        schema.TypeSet,
 					Optional:    true,
-					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Description: tfsts.AssumeRolePolicyARNsDescription,
 					Elem: &schema.Schema{
 						Type:         schema.TypeString,
 						ValidateFunc: verify.ValidARN,
@@ -642,31 +870,31 @@ func assumeRoleSchema() *schema.Schema {
 				"role_arn": {
 					Type:         schema.TypeString,
 					Optional:     true, // For historical reasons, we allow an empty `assume_role` block
-					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+					Description:  tfsts.AssumeRoleRoleARNDescription,
 					ValidateFunc: verify.ValidARN,
 				},
 				"session_name": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "An identifier for the assumed role session.",
+					Description:  tfsts.AssumeRoleSessionNameDescription,
 					ValidateFunc: validAssumeRoleSessionName,
 				},
 				"source_identity": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "Source identity specified by the principal assuming the role.",
+					Description:  tfsts.AssumeRoleSourceIdentityDescription,
 					ValidateFunc: validAssumeRoleSourceIdentity,
 				},
 				"tags": {
 					Type:        schema.TypeMap,
 					Optional:    true,
-					Description: "Assume role session tags.",
+					Description: tfsts.AssumeRoleTagsDescription,
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
 				"transitive_tag_keys": {
 					Type:        schema.TypeSet,
 					Optional:    true,
-					Description: "Assume role session tag keys to pass to any subsequent sessions.",
+					Description: tfsts.AssumeRoleTransitiveTagKeysDescription,
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
 			},
@@ -684,19 +912,19 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 				"duration": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					Description:  tfsts.AssumeRoleDurationDescription,
 					ValidateFunc: validAssumeRoleDuration,
 				},
 				"policy": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					Description:  tfsts.AssumeRolePolicyDescription,
 					ValidateFunc: validation.StringIsJSON,
 				},
 				"policy_arns": {
 					Type:        schema.TypeSet,
 					Optional:    true,
-					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Description: tfsts.AssumeRolePolicyARNsDescription,
 					Elem: &schema.Schema{
 						Type:         schema.TypeString,
 						ValidateFunc: verify.ValidARN,
@@ -705,19 +933,19 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 				"role_arn": {
 					Type:         schema.TypeString,
 					Optional:     true, // For historical reasons, we allow an empty `assume_role_with_web_identity` block
-					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+					Description:  tfsts.AssumeRoleRoleARNDescription,
 					ValidateFunc: verify.ValidARN,
 				},
 				"session_name": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					Description:  "An identifier for the assumed role session.",
+					Description:  tfsts.AssumeRoleSessionNameDescription,
 					ValidateFunc: validAssumeRoleSessionName,
 				},
 				"web_identity_token": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: validation.StringLenBetween(4, 20000),
+					ValidateFunc: validation.StringLenBetween(tfsts.WebIdentityTokenMinLength, tfsts.WebIdentityTokenMaxLength),
 					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
 				},
 				"web_identity_token_file": {
@@ -861,6 +1089,158 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandServiceConcurrency(_ context.Context, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("service_concurrency")
+	serviceConcurrency := make(map[string]int)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service, ok := providerPackageForService(tfMap["service"].(string))
+		if !ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.IndexInt(i).GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unsupported service %q.", tfMap["service"].(string)),
+			))
+			continue
+		}
+
+		if _, ok := serviceConcurrency[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.IndexInt(i).GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate service %q.", service),
+			))
+			continue
+		}
+
+		serviceConcurrency[service] = tfMap["max_in_flight"].(int)
+	}
+
+	return serviceConcurrency, diags
+}
+
+func expandReadOnlyAllowed(_ context.Context, tfList []any) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("read_only_allowed")
+	readOnlyAllowed := make(map[string][]string)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service, ok := providerPackageForService(tfMap["service"].(string))
+		if !ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.IndexInt(i).GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unsupported service %q.", tfMap["service"].(string)),
+			))
+			continue
+		}
+
+		if _, ok := readOnlyAllowed[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.IndexInt(i).GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate service %q.", service),
+			))
+			continue
+		}
+
+		var operations []string
+		if v, ok := tfMap["operations"].(*schema.Set); ok {
+			operations = flex.ExpandStringValueSet(v)
+		}
+		readOnlyAllowed[service] = operations
+	}
+
+	return readOnlyAllowed, diags
+}
+
+// providerPackageForService returns the provider package name for the specified service name or alias,
+// as used in the `endpoints` configuration block.
+func providerPackageForService(service string) (string, bool) {
+	if slices.Contains(names.ProviderPackages(), service) {
+		return service, true
+	}
+
+	pkg, err := names.ProviderPackageForAlias(service)
+	if err != nil {
+		return "", false
+	}
+
+	return pkg, true
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("tag_policy").IndexInt(0)
+	tagPolicyConfig := &tftags.PolicyConfig{
+		Enforcement: tftags.PolicyEnforcementError,
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		tagPolicyConfig.Enforcement = tftags.PolicyEnforcement(v)
+	}
+	if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		tagPolicyConfig.ExemptResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		tagPolicyConfig.KeyCase = tftags.PolicyCase(v)
+	}
+
+	if v, ok := tfMap["rule"].([]any); ok {
+		for i, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key:      tfMap[names.AttrKey].(string),
+				Required: tfMap["required"].(bool),
+			}
+			if v, ok := tfMap["allowed_pattern"].(string); ok && v != "" {
+				// The schema validation is skipped when the value is unknown at validation time.
+				re, err := regexp.Compile(v)
+				if err != nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(
+						path.GetAttr("rule").IndexInt(i).GetAttr("allowed_pattern"),
+						"Invalid Attribute Value",
+						fmt.Sprintf("Invalid regular expression %q: %s.", v, err),
+					))
+					continue
+				}
+				rule.AllowedPattern = re
+			}
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+			if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+				rule.ExemptResourceTypes = flex.ExpandStringValueSet(v)
+			}
+			if v, ok := tfMap["value_case"].(string); ok && v != "" {
+				rule.ValueCase = tftags.PolicyCase(v)
+			}
+
+			tagPolicyConfig.Rules = append(tagPolicyConfig.Rules, rule)
+		}
+	}
+
+	return tagPolicyConfig, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
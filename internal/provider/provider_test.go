@@ -424,6 +424,62 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		allowedPattern string
+		expectError    bool
+	}{
+		"no pattern": {},
+		"valid pattern": {
+			allowedPattern: `^[a-z]+$`,
+		},
+		"invalid pattern": {
+			allowedPattern: `^[a-z+$`,
+			expectError:    true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := expandTagPolicy(ctx, map[string]any{
+				"enforcement": string(tftags.PolicyEnforcementWarning),
+				"rule": []any{
+					map[string]any{
+						names.AttrKey:           "CostCenter",
+						"required":              true,
+						"allowed_pattern":       testcase.allowedPattern,
+						"allowed_values":        schema.NewSet(schema.HashString, nil),
+						"exempt_resource_types": schema.NewSet(schema.HashString, nil),
+						"value_case":            "",
+					},
+				},
+			})
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error %t, got diagnostics: %v", want, diags)
+			}
+			if testcase.expectError {
+				return
+			}
+
+			if got, want := result.Enforcement, tftags.PolicyEnforcementWarning; got != want {
+				t.Errorf("expected enforcement %q, got %q", want, got)
+			}
+			if got, want := len(result.Rules), 1; got != want {
+				t.Fatalf("expected %d rules, got %d", want, got)
+			}
+			if got, want := result.Rules[0].AllowedPattern != nil, testcase.allowedPattern != ""; got != want {
+				t.Errorf("expected allowed pattern set %t, got %t", want, got)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
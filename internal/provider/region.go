@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema of the `region` argument injected into Regional resources and data sources.
+func regionSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		Description:  "Region where this resource will be managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: validRegionName,
+	}
+}
+
+// injectRegionSchema adds the `region` argument to the specified resource or data source schema.
+// It returns false if the schema already defines a `region` attribute.
+func injectRegionSchema(r *schema.Resource, forceNew bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = regionSchema(forceNew)
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = regionSchema(forceNew)
+	}
+
+	return true
+}
+
+func validRegionName(v any, k string) (ws []string, errors []error) {
+	if value := v.(string); !conns.IsValidRegionName(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid AWS Region name", k, value))
+	}
+
+	return
+}
+
+// regionFromAttribute returns a copy of the Context with the Region override set from the `region` attribute, if any.
+func regionFromAttribute(ctx context.Context, getAttribute getAttributeFunc) context.Context {
+	if getAttribute == nil {
+		return ctx
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if region, ok := v.(string); ok && region != "" {
+			ctx = conns.WithOverrideRegion(ctx, region)
+		}
+	}
+
+	return ctx
+}
+
+// setRegionInState is an interceptor that sets the effective Region in state after a successful operation.
+func setRegionInState() interceptor {
+	return interceptorFunc(func(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		// The resource has been deleted or not found.
+		if opts.d.Id() == "" {
+			return diags
+		}
+
+		if err := opts.d.Set(names.AttrRegion, opts.c.Region(ctx)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+		}
+
+		return diags
+	})
+}
+
+// setRegionInPlan is a CustomizeDiff function that plans the provider's configured Region
+// for a new resource with no `region` argument.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig(); v.IsNull() || !v.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx))
+}
+
+// importWithRegion strips any Region suffix from the import ID, setting the `region` attribute.
+func importWithRegion(d *schema.ResourceData) error {
+	id, region := conns.SplitImportIDRegion(d.Id())
+	if region == "" {
+		return nil
+	}
+
+	d.SetId(id)
+
+	return d.Set(names.AttrRegion, region)
+}
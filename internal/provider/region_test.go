@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectRegionSchema(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		resource func() *schema.Resource
+		expected bool
+	}{
+		"Schema": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				}
+			},
+			expected: true,
+		},
+		"SchemaFunc": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					SchemaFunc: func() map[string]*schema.Schema {
+						return map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						}
+					},
+				}
+			},
+			expected: true,
+		},
+		"Schema with region": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				}
+			},
+		},
+		"SchemaFunc with region": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					SchemaFunc: func() map[string]*schema.Schema {
+						return map[string]*schema.Schema{
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						}
+					},
+				}
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testcase.resource()
+
+			if got, want := injectRegionSchema(r, true), testcase.expected; got != want {
+				t.Fatalf("expected %t, got %t", want, got)
+			}
+
+			schemaMap := r.SchemaMap()
+			if _, ok := schemaMap[names.AttrName]; testcase.expected && !ok {
+				t.Errorf("expected %s attribute to be retained", names.AttrName)
+			}
+
+			v, ok := schemaMap[names.AttrRegion]
+			if !ok {
+				t.Fatalf("expected %s attribute", names.AttrRegion)
+			}
+
+			if testcase.expected {
+				if !v.Optional || !v.Computed || !v.ForceNew {
+					t.Errorf("expected injected %s attribute to be Optional, Computed and ForceNew", names.AttrRegion)
+				}
+			} else if !v.Required {
+				t.Errorf("expected existing %s attribute to be unchanged", names.AttrRegion)
+			}
+		})
+	}
+}
+
+func TestRegionFromAttribute(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		getAttribute getAttributeFunc
+		expected     string
+	}{
+		"nil": {
+			expected: "us-west-2",
+		},
+		"unset": {
+			getAttribute: func(string) (any, bool) { return "", false },
+			expected:     "us-west-2",
+		},
+		"set": {
+			getAttribute: func(string) (any, bool) { return "eu-west-1", true },
+			expected:     "eu-west-1",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", "aws_test")
+			ctx = conns.WithOverrideRegion(ctx, "us-west-2")
+			ctx = regionFromAttribute(ctx, testcase.getAttribute)
+
+			if got, want := new(conns.AWSClient).Region(ctx), testcase.expected; got != want {
+				t.Errorf("expected Region %q, got %q", want, got)
+			}
+		})
+	}
+}
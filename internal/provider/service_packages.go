@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ServicePackages returns the service packages registered with the provider.
+func ServicePackages(ctx context.Context) []conns.ServicePackage {
+	return servicePackages(ctx)
+}
@@ -263,6 +263,53 @@ func (r tagsInterceptor) getIdentifier(d schemaResourceData) string {
 	return identifier
 }
 
+// tagPolicyDiagnostics returns an error diagnostic for each way in which the resource's tags violate any provider configured tag_policy.
+func tagPolicyDiagnostics(ctx context.Context, c *conns.AWSClient, tags tftags.KeyValueTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	tagPolicyConfig := c.TagPolicyConfig(ctx)
+	for _, violation := range tagPolicyConfig.Violations(inContext.TypeName(), tags) {
+		diags = sdkdiag.AppendErrorf(diags, "tag policy violation: %s: %s", inContext.TypeName(), violation)
+	}
+
+	return diags
+}
+
+// validateTagPolicy is a CustomizeDiff function that checks the resource's planned tags, including any provider
+// configured default_tags, against any provider configured tag_policy.
+// Warnings are reported by tagPolicyProviderServer as CustomizeDiff functions cannot return them.
+func validateTagPolicy(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if tagPolicyConfig := c.TagPolicyConfig(ctx); tagPolicyConfig == nil || tagPolicyConfig.IsWarning() {
+		return nil
+	}
+
+	// Tags that aren't yet known are checked when the plan is finalized during apply.
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName())
+
+	if diags := tagPolicyDiagnostics(ctx, c, tags); diags.HasError() {
+		return sdkdiag.DiagnosticsError(diags)
+	}
+
+	return nil
+}
+
 // setTagsAll is a CustomizeDiff function that calculates the new value for the `tags_all` attribute.
 func setTagsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	c := meta.(*conns.AWSClient)
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyProviderServer wraps the Plugin SDK provider server and reports violations of any provider configured
+// tag_policy in warning mode at plan time.
+// Plugin SDK CustomizeDiff functions can only return errors, so these warnings cannot be reported by validateTagPolicy.
+type tagPolicyProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+	// taggedResources maps the type name of each resource that has opted in to transparent tagging to its service package name.
+	taggedResources map[string]string
+}
+
+func newTagPolicyProviderServerFactory(ctx context.Context, provider *schema.Provider) func() tfprotov5.ProviderServer {
+	taggedResources := make(map[string]string)
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Tags != nil {
+				taggedResources[v.TypeName] = sp.ServicePackageName()
+			}
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &tagPolicyProviderServer{
+			ProviderServer:  provider.GRPCProvider(),
+			provider:        provider,
+			taggedResources: taggedResources,
+		}
+	}
+}
+
+func (s *tagPolicyProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	if err != nil || response == nil || response.PlannedState == nil {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v != nil && v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, nil
+		}
+	}
+
+	response.Diagnostics = append(response.Diagnostics, s.tagPolicyWarnings(ctx, request.TypeName, response.PlannedState)...)
+
+	return response, nil
+}
+
+// tagPolicyWarnings returns a warning diagnostic for each way in which the resource's planned tags, including any
+// provider configured default_tags, violate any provider configured tag_policy in warning mode.
+func (s *tagPolicyProviderServer) tagPolicyWarnings(ctx context.Context, typeName string, plannedState *tfprotov5.DynamicValue) []*tfprotov5.Diagnostic {
+	var diags []*tfprotov5.Diagnostic
+
+	c, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok {
+		return diags
+	}
+
+	tagPolicyConfig := c.TagPolicyConfig(ctx)
+	if tagPolicyConfig == nil || !tagPolicyConfig.IsWarning() {
+		return diags
+	}
+
+	servicePackageName, ok := s.taggedResources[typeName]
+	if !ok {
+		return diags
+	}
+
+	r, ok := s.provider.ResourcesMap[typeName]
+	if !ok {
+		return diags
+	}
+
+	plan, err := msgpack.Unmarshal(plannedState.MsgPack, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		tflog.Warn(ctx, "decoding planned state for tag policy", map[string]any{
+			"tf_aws.resource_type": typeName,
+			"error":                err.Error(),
+		})
+		return diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if plan.IsNull() {
+		return diags
+	}
+
+	// Tags that aren't yet known are checked when the plan is finalized during apply.
+	planTags := plan.GetAttr(names.AttrTags)
+	if !planTags.IsWhollyKnown() {
+		return diags
+	}
+
+	configTags := make(map[string]string)
+	if !planTags.IsNull() {
+		for k, v := range planTags.AsValueMap() {
+			if !v.IsNull() {
+				configTags[k] = v.AsString()
+			}
+		}
+	}
+
+	tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, configTags))
+	tags = tags.IgnoreSystem(servicePackageName)
+
+	for _, violation := range tagPolicyConfig.Violations(typeName, tags) {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   "Tag policy violation",
+			Detail:    fmt.Sprintf("%s: %s", typeName, violation),
+			Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+		})
+	}
+
+	return diags
+}
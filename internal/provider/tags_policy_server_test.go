@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockPlanResourceChangeServer struct {
+	tfprotov5.ProviderServer
+	response *tfprotov5.PlanResourceChangeResponse
+}
+
+func (s mockPlanResourceChangeServer) PlanResourceChange(context.Context, *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return s.response, nil
+}
+
+func TestTagPolicyProviderServer_PlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typeName := "aws_test"
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	testcases := map[string]struct {
+		enforcement  tftags.PolicyEnforcement
+		typeName     string
+		plannedState cty.Value
+		wantWarnings int
+	}{
+		"compliant": {
+			enforcement: tftags.PolicyEnforcementWarning,
+			typeName:    typeName,
+			plannedState: cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.UnknownVal(cty.String),
+				names.AttrTags:    cty.MapVal(map[string]cty.Value{"CostCenter": cty.StringVal("1234")}),
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			}),
+		},
+		"violation": {
+			enforcement: tftags.PolicyEnforcementWarning,
+			typeName:    typeName,
+			plannedState: cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.UnknownVal(cty.String),
+				names.AttrTags:    cty.NullVal(cty.Map(cty.String)),
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			}),
+			wantWarnings: 1,
+		},
+		"error enforcement": {
+			enforcement: tftags.PolicyEnforcementError,
+			typeName:    typeName,
+			plannedState: cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.UnknownVal(cty.String),
+				names.AttrTags:    cty.NullVal(cty.Map(cty.String)),
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			}),
+		},
+		"unknown tags": {
+			enforcement: tftags.PolicyEnforcementWarning,
+			typeName:    typeName,
+			plannedState: cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.UnknownVal(cty.String),
+				names.AttrTags:    cty.UnknownVal(cty.Map(cty.String)),
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			}),
+		},
+		"destroy": {
+			enforcement:  tftags.PolicyEnforcementWarning,
+			typeName:     typeName,
+			plannedState: cty.NullVal(ty),
+		},
+		"untagged resource type": {
+			enforcement: tftags.PolicyEnforcementWarning,
+			typeName:    "aws_untagged",
+			plannedState: cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.UnknownVal(cty.String),
+				names.AttrTags:    cty.NullVal(cty.Map(cty.String)),
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			}),
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := msgpack.Marshal(testcase.plannedState, ty)
+			if err != nil {
+				t.Fatalf("encoding planned state: %s", err)
+			}
+
+			c := new(conns.AWSClient)
+			conns.SetTagPolicyConfig(c, &tftags.PolicyConfig{
+				Enforcement: testcase.enforcement,
+				Rules: []tftags.PolicyRule{
+					{Key: "CostCenter", Required: true},
+				},
+			})
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					typeName:       r,
+					"aws_untagged": r,
+				},
+			}
+			provider.SetMeta(c)
+
+			server := &tagPolicyProviderServer{
+				ProviderServer: mockPlanResourceChangeServer{
+					response: &tfprotov5.PlanResourceChangeResponse{
+						PlannedState: &tfprotov5.DynamicValue{MsgPack: b},
+					},
+				},
+				provider:        provider,
+				taggedResources: map[string]string{typeName: "test"},
+			}
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{TypeName: testcase.typeName})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var warnings int
+			for _, v := range response.Diagnostics {
+				if v.Severity != tfprotov5.DiagnosticSeverityWarning {
+					t.Errorf("unexpected diagnostic: %s: %s", v.Summary, v.Detail)
+					continue
+				}
+				warnings++
+			}
+
+			if got, want := warnings, testcase.wantWarnings; got != want {
+				t.Errorf("expected %d warnings, got %d", want, got)
+			}
+		})
+	}
+}
@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -21,7 +21,7 @@ func validAssumeRoleDuration(v any, k string) (ws []string, errors []error) {
 		return
 	}
 
-	if duration.Minutes() < 15 || duration.Hours() > 12 {
+	if duration < tfsts.AssumeRoleDurationMin || duration > tfsts.AssumeRoleDurationMax {
 		errors = append(errors, fmt.Errorf("duration %q must be between 15 minutes (15m) and 12 hours (12h), inclusive", k))
 	}
 
@@ -29,11 +29,11 @@ func validAssumeRoleDuration(v any, k string) (ws []string, errors []error) {
 }
 
 var validAssumeRoleSessionName = validation.All(
-	validation.StringLenBetween(2, 64),
-	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
+	validation.StringLenBetween(tfsts.AssumeRoleSessionNameMinLength, tfsts.AssumeRoleSessionNameMaxLength),
+	validation.StringMatch(tfsts.AssumeRoleSessionNameRegexp, ""),
 )
 
 var validAssumeRoleSourceIdentity = validation.All(
-	validation.StringLenBetween(2, 64),
-	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
+	validation.StringLenBetween(tfsts.AssumeRoleSourceIdentityMinLength, tfsts.AssumeRoleSourceIdentityMaxLength),
+	validation.StringMatch(tfsts.AssumeRoleSourceIdentityRegexp, ""),
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // Implemented by (schema.ResourceData|schema.ResourceDiff).GetOk().
@@ -47,10 +48,12 @@ func (w *wrappedDataSource) read(f schema.ReadContextFunc) schema.ReadContextFun
 
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext   contextFunc
-	customizeDiffFuncs []schema.CustomizeDiffFunc
-	interceptors       interceptorItems
-	typeName           string
+	bootstrapContext        contextFunc
+	customizeDiffFuncs      []schema.CustomizeDiffFunc
+	identity                types.Identity
+	interceptors            interceptorItems
+	isRegionOverrideEnabled bool
+	typeName                string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -114,6 +117,18 @@ func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFu
 	}
 
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if w.opts.identity.HasIdentity() {
+			if err := importIDFromIdentity(ctx, d, meta, w.opts.identity, w.opts.isRegionOverrideEnabled); err != nil {
+				return nil, err
+			}
+		}
+
+		if w.opts.isRegionOverrideEnabled {
+			if err := importWithRegion(d); err != nil {
+				return nil, err
+			}
+		}
+
 		ctx, diags := w.opts.bootstrapContext(ctx, d.GetOk, meta)
 		if diags.HasError() {
 			return nil, sdkdiag.DiagnosticsError(diags)
@@ -0,0 +1,1292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagent_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"conditional": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrCondition: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"data": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_output": schema.StringAttribute{
+																Required: true,
+															},
+															"target_input": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"node": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: flowNodeConfigurationBlock(ctx),
+									"input": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrExpression: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func flowNodeConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	emptyBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowEmptyNodeConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+	s3Block := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowS3ServiceConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrBucketName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+	guardrailConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"guardrail_identifier": schema.StringAttribute{
+						Required: true,
+					},
+					"guardrail_version": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"agent": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowAgentNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"agent_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"collector": emptyBlock(),
+				names.AttrCondition: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeBetween(1, 5),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrExpression: schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"input":    emptyBlock(),
+				"iterator": emptyBlock(),
+				"knowledge_base": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowKnowledgeBaseNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"knowledge_base_id": schema.StringAttribute{
+								Required: true,
+							},
+							"model_id": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"guardrail_configuration": guardrailConfigurationBlock(),
+						},
+					},
+				},
+				"lambda_function": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowLambdaFunctionNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"lambda_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"lex": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowLexNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"bot_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+							"locale_id": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"output": emptyBlock(),
+				"prompt": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowPromptNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"guardrail_configuration": guardrailConfigurationBlock(),
+							"source_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowPromptNodeSourceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"inline": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[flowPromptNodeInlineConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"additional_model_request_fields": schema.StringAttribute{
+														CustomType: jsontypes.NormalizedType{},
+														Optional:   true,
+													},
+													"model_id": schema.StringAttribute{
+														Required: true,
+													},
+													"template_type": schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+														Required:   true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"inference_configuration": promptInferenceConfigurationBlock(ctx),
+													"template_configuration":  promptTemplateConfigurationBlock(ctx),
+												},
+											},
+										},
+										"resource": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[flowPromptNodeResourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"prompt_arn": schema.StringAttribute{
+														CustomType: fwtypes.ARNType,
+														Required:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"retrieval": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowRetrievalNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowRetrievalNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"storage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowStorageNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowStorageNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	name := data.Name.ValueString()
+	var input bedrockagent.CreateFlowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.Id)
+	data.ID = types.StringValue(id)
+
+	if data.PrepareFlow.ValueBool() && input.Definition != nil {
+		if _, err := prepareFlow(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	flow, err := findFlowByID(ctx, conn, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, flow.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, flow.CreatedAt)
+	data.Status = fwtypes.StringEnumValue(flow.Status)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, flow.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		var input bedrockagent.UpdateFlowInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FlowIdentifier = aws.String(id)
+
+		_, err := conn.UpdateFlow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", id), err.Error())
+
+			return
+		}
+
+		if new.PrepareFlow.ValueBool() && input.Definition != nil {
+			if _, err := prepareFlow(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	flow, err := findFlowByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", id), err.Error())
+
+		return
+	}
+
+	new.Status = fwtypes.StringEnumValue(flow.Status)
+	new.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	id := data.ID.ValueString()
+	input := bedrockagent.DeleteFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+	_, err := conn.DeleteFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_flow to default value on import
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_flow"), true)...)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, &input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	output, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return output, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+	output, err := conn.GetFlow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Validations, func(v awstypes.FlowValidation) error {
+			return errors.New(aws.ToString(v.Message))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	PrepareFlow              types.Bool                                           `tfsdk:"prepare_flow"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                           `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+var (
+	_ fwflex.Expander  = flowConnectionConfigurationModel{}
+	_ fwflex.Flattener = &flowConnectionConfigurationModel{}
+)
+
+func (m flowConnectionConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Conditional.Elements()) > 0:
+		flowConditionalConnectionConfigurationData := fwdiag.Must(m.Conditional.ToPtr(ctx))
+		var r awstypes.FlowConnectionConfigurationMemberConditional
+		diags.Append(fwflex.Expand(ctx, flowConditionalConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Data.Elements()) > 0:
+		flowDataConnectionConfigurationData := fwdiag.Must(m.Data.ToPtr(ctx))
+		var r awstypes.FlowConnectionConfigurationMemberData
+		diags.Append(fwflex.Expand(ctx, flowDataConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowConnectionConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowConnectionConfigurationMemberConditional:
+		var model flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowConnectionConfigurationMemberData:
+		var model flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[flowAgentNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[flowConditionNodeConfigurationModel]      `tfsdk:"condition"`
+	Input          fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[flowKnowledgeBaseNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[flowLambdaFunctionNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[flowLexNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[flowEmptyNodeConfigurationModel]          `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[flowPromptNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[flowRetrievalNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[flowStorageNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+var (
+	_ fwflex.Expander  = flowNodeConfigurationModel{}
+	_ fwflex.Flattener = &flowNodeConfigurationModel{}
+)
+
+func (m flowNodeConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Agent.Elements()) > 0:
+		flowAgentNodeConfigurationData := fwdiag.Must(m.Agent.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberAgent
+		diags.Append(fwflex.Expand(ctx, flowAgentNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Collector.Elements()) > 0:
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+
+	case len(m.Condition.Elements()) > 0:
+		flowConditionNodeConfigurationData := fwdiag.Must(m.Condition.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberCondition
+		diags.Append(fwflex.Expand(ctx, flowConditionNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Input.Elements()) > 0:
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+
+	case len(m.Iterator.Elements()) > 0:
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+
+	case len(m.KnowledgeBase.Elements()) > 0:
+		flowKnowledgeBaseNodeConfigurationData := fwdiag.Must(m.KnowledgeBase.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberKnowledgeBase
+		diags.Append(fwflex.Expand(ctx, flowKnowledgeBaseNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.LambdaFunction.Elements()) > 0:
+		flowLambdaFunctionNodeConfigurationData := fwdiag.Must(m.LambdaFunction.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberLambdaFunction
+		diags.Append(fwflex.Expand(ctx, flowLambdaFunctionNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Lex.Elements()) > 0:
+		flowLexNodeConfigurationData := fwdiag.Must(m.Lex.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberLex
+		diags.Append(fwflex.Expand(ctx, flowLexNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Output.Elements()) > 0:
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+
+	case len(m.Prompt.Elements()) > 0:
+		flowPromptNodeConfigurationData := fwdiag.Must(m.Prompt.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberPrompt
+		diags.Append(fwflex.Expand(ctx, flowPromptNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Retrieval.Elements()) > 0:
+		flowRetrievalNodeConfigurationData := fwdiag.Must(m.Retrieval.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberRetrieval
+		diags.Append(fwflex.Expand(ctx, flowRetrievalNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Storage.Elements()) > 0:
+		flowStorageNodeConfigurationData := fwdiag.Must(m.Storage.ToPtr(ctx))
+		var r awstypes.FlowNodeConfigurationMemberStorage
+		diags.Append(fwflex.Expand(ctx, flowStorageNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowNodeConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowNodeConfigurationMemberAgent:
+		var model flowAgentNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberCollector:
+		m.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowEmptyNodeConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberCondition:
+		var model flowConditionNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberInput:
+		m.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowEmptyNodeConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberIterator:
+		m.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowEmptyNodeConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var model flowKnowledgeBaseNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var model flowLambdaFunctionNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberLex:
+		var model flowLexNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberOutput:
+		m.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowEmptyNodeConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberPrompt:
+		var model flowPromptNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberRetrieval:
+		var model flowRetrievalNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.FlowNodeConfigurationMemberStorage:
+		var model flowStorageNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type flowEmptyNodeConfigurationModel struct{}
+
+type flowAgentNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type flowConditionNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type flowKnowledgeBaseNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel] `tfsdk:"guardrail_configuration"`
+	KnowledgeBaseID        types.String                                                 `tfsdk:"knowledge_base_id"`
+	ModelID                types.String                                                 `tfsdk:"model_id"`
+}
+
+type flowLambdaFunctionNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type flowLexNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type flowPromptNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]            `tfsdk:"guardrail_configuration"`
+	SourceConfiguration    fwtypes.ListNestedObjectValueOf[flowPromptNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type flowPromptNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[flowPromptNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[flowPromptNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+var (
+	_ fwflex.Expander  = flowPromptNodeSourceConfigurationModel{}
+	_ fwflex.Flattener = &flowPromptNodeSourceConfigurationModel{}
+)
+
+func (m flowPromptNodeSourceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Inline.Elements()) > 0:
+		flowPromptNodeInlineConfigurationData := fwdiag.Must(m.Inline.ToPtr(ctx))
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberInline
+		diags.Append(fwflex.Expand(ctx, flowPromptNodeInlineConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v, d := expandSmithyDocument(flowPromptNodeInlineConfigurationData.AdditionalModelRequestFields)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		r.Value.AdditionalModelRequestFields = v
+
+		return &r, diags
+
+	case len(m.Resource.Elements()) > 0:
+		flowPromptNodeResourceConfigurationData := fwdiag.Must(m.Resource.ToPtr(ctx))
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberResource
+		diags.Append(fwflex.Expand(ctx, flowPromptNodeResourceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowPromptNodeSourceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		var model flowPromptNodeInlineConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var model flowPromptNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type flowPromptNodeInlineConfigurationModel struct {
+	AdditionalModelRequestFields jsontypes.Normalized                                               `tfsdk:"additional_model_request_fields"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type flowPromptNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type flowRetrievalNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowRetrievalNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type flowRetrievalNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowS3ServiceConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = flowRetrievalNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &flowRetrievalNodeServiceConfigurationModel{}
+)
+
+func (m flowRetrievalNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.S3.Elements()) > 0:
+		flowS3ServiceConfigurationData := fwdiag.Must(m.S3.ToPtr(ctx))
+		var r awstypes.RetrievalFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowS3ServiceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowRetrievalNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RetrievalFlowNodeServiceConfigurationMemberS3:
+		var model flowS3ServiceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type flowStorageNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowStorageNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type flowStorageNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowS3ServiceConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = flowStorageNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &flowStorageNodeServiceConfigurationModel{}
+)
+
+func (m flowStorageNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.S3.Elements()) > 0:
+		flowS3ServiceConfigurationData := fwdiag.Must(m.S3.ToPtr(ctx))
+		var r awstypes.StorageFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowS3ServiceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowStorageNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.StorageFlowNodeServiceConfigurationMemberS3:
+		var model flowS3ServiceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type flowS3ServiceConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
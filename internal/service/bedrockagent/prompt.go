@@ -0,0 +1,1128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagent_prompt", name="Prompt")
+// @Tags(identifierAttribute="arn")
+func newPromptResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &promptResource{}, nil
+}
+
+type promptResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *promptResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"default_variant": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variant": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[promptVariantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"additional_model_request_fields": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+						"model_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+							},
+						},
+						"template_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"gen_ai_resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[promptGenAIResourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"agent": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[promptAgentResourceModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"agent_identifier": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"inference_configuration": promptInferenceConfigurationBlock(ctx),
+						"metadata": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[promptMetadataEntryModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(50),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+								},
+							},
+						},
+						"template_configuration": promptTemplateConfigurationBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *promptResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	name := data.Name.ValueString()
+	var input bedrockagent.CreatePromptInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(expandPromptVariantsAdditionalModelRequestFields(ctx, data.Variants, input.Variants)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePrompt(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Prompt (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *promptResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findPromptByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Prompt (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old promptResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.DefaultVariant.Equal(old.DefaultVariant) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Variants.Equal(old.Variants) {
+		var input bedrockagent.UpdatePromptInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(expandPromptVariantsAdditionalModelRequestFields(ctx, new.Variants, input.Variants)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.PromptIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdatePrompt(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Prompt (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	} else {
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *promptResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := bedrockagent.DeletePromptInput{
+		PromptIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeletePrompt(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Prompt (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findPromptByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetPromptOutput, error) {
+	input := bedrockagent.GetPromptInput{
+		PromptIdentifier: aws.String(id),
+	}
+
+	return findPrompt(ctx, conn, &input)
+}
+
+func findPrompt(ctx context.Context, conn *bedrockagent.Client, input *bedrockagent.GetPromptInput) (*bedrockagent.GetPromptOutput, error) {
+	output, err := conn.GetPrompt(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// expandPromptVariantsAdditionalModelRequestFields sets the additional model request fields of each prompt variant.
+// Nested JSON documents can't be expanded by AutoFlex.
+func expandPromptVariantsAdditionalModelRequestFields(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptVariantModel], apiObjects []awstypes.PromptVariant) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	variants, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, variant := range variants {
+		if i >= len(apiObjects) {
+			break
+		}
+
+		v, d := expandSmithyDocument(variant.AdditionalModelRequestFields)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		apiObjects[i].AdditionalModelRequestFields = v
+	}
+
+	return diags
+}
+
+func expandSmithyDocument(v jsontypes.Normalized) (document.Interface, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	doc, err := tfjson.SmithyDocumentFromString(v.ValueString(), document.NewLazyDocument)
+	if err != nil {
+		diags.AddError("expanding JSON document", err.Error())
+		return nil, diags
+	}
+
+	return doc, diags
+}
+
+func promptInferenceConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptInferenceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[promptModelInferenceConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"max_tokens": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"stop_sequences": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
+							},
+							"temperature": schema.Float64Attribute{
+								Optional: true,
+								Validators: []validator.Float64{
+									float64validator.Between(0, 1),
+								},
+							},
+							"top_p": schema.Float64Attribute{
+								Optional: true,
+								Validators: []validator.Float64{
+									float64validator.Between(0, 1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func promptTemplateConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptTemplateConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"chat": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[chatPromptTemplateConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"input_variable": promptInputVariableBlock(ctx),
+							"message": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[messageModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrRole: schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.ConversationRole](),
+											Required:   true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										names.AttrContent: schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[contentBlockModel](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"text": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"cache_point": cachePointBlock(ctx),
+												},
+											},
+										},
+									},
+								},
+							},
+							"system": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[systemContentBlockModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"text": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"cache_point": cachePointBlock(ctx),
+									},
+								},
+							},
+							"tool_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[toolConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"tool": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[toolModel](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"cache_point": cachePointBlock(ctx),
+													"tool_spec": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[toolSpecificationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																names.AttrDescription: schema.StringAttribute{
+																	Optional: true,
+																},
+																names.AttrName: schema.StringAttribute{
+																	Required: true,
+																},
+															},
+															Blocks: map[string]schema.Block{
+																"input_schema": schema.ListNestedBlock{
+																	CustomType: fwtypes.NewListNestedObjectTypeOf[toolInputSchemaModel](ctx),
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(1),
+																	},
+																	NestedObject: schema.NestedBlockObject{
+																		Attributes: map[string]schema.Attribute{
+																			"json": schema.StringAttribute{
+																				CustomType: jsontypes.NormalizedType{},
+																				Optional:   true,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"tool_choice": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[toolChoiceModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"any": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[anyToolChoiceModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+													},
+													"auto": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[autoToolChoiceModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+													},
+													"tool": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[specificToolChoiceModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																names.AttrName: schema.StringAttribute{
+																	Required: true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[textPromptTemplateConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"text": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"cache_point":    cachePointBlock(ctx),
+							"input_variable": promptInputVariableBlock(ctx),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func cachePointBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[cachePointBlockModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.CachePointType](),
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func promptInputVariableBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptInputVariableModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+type promptResourceModel struct {
+	ARN                      types.String                                        `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                   `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                         `tfsdk:"customer_encryption_key_arn"`
+	DefaultVariant           types.String                                        `tfsdk:"default_variant"`
+	Description              types.String                                        `tfsdk:"description"`
+	ID                       types.String                                        `tfsdk:"id"`
+	Name                     types.String                                        `tfsdk:"name"`
+	Tags                     tftags.Map                                          `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                          `tfsdk:"tags_all"`
+	UpdatedAt                timetypes.RFC3339                                   `tfsdk:"updated_at"`
+	Variants                 fwtypes.ListNestedObjectValueOf[promptVariantModel] `tfsdk:"variant"`
+	Version                  types.String                                        `tfsdk:"version"`
+}
+
+type promptVariantModel struct {
+	AdditionalModelRequestFields jsontypes.Normalized                                               `tfsdk:"additional_model_request_fields"`
+	GenAIResource                fwtypes.ListNestedObjectValueOf[promptGenAIResourceModel]          `tfsdk:"gen_ai_resource"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	Metadata                     fwtypes.SetNestedObjectValueOf[promptMetadataEntryModel]           `tfsdk:"metadata"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	Name                         types.String                                                       `tfsdk:"name"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptGenAIResourceModel struct {
+	Agent fwtypes.ListNestedObjectValueOf[promptAgentResourceModel] `tfsdk:"agent"`
+}
+
+var (
+	_ fwflex.Expander  = promptGenAIResourceModel{}
+	_ fwflex.Flattener = &promptGenAIResourceModel{}
+)
+
+func (m promptGenAIResourceModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Agent.Elements()) > 0:
+		promptAgentResourceData := fwdiag.Must(m.Agent.ToPtr(ctx))
+		var r awstypes.PromptGenAiResourceMemberAgent
+		diags.Append(fwflex.Expand(ctx, promptAgentResourceData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptGenAIResourceModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptGenAiResourceMemberAgent:
+		var model promptAgentResourceModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type promptAgentResourceModel struct {
+	AgentIdentifier fwtypes.ARN `tfsdk:"agent_identifier"`
+}
+
+type promptInferenceConfigurationModel struct {
+	Text fwtypes.ListNestedObjectValueOf[promptModelInferenceConfigurationModel] `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = promptInferenceConfigurationModel{}
+	_ fwflex.Flattener = &promptInferenceConfigurationModel{}
+)
+
+func (m promptInferenceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Text.Elements()) > 0:
+		promptModelInferenceConfigurationData := fwdiag.Must(m.Text.ToPtr(ctx))
+		var r awstypes.PromptInferenceConfigurationMemberText
+		diags.Append(fwflex.Expand(ctx, promptModelInferenceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptInferenceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptInferenceConfigurationMemberText:
+		var model promptModelInferenceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type promptModelInferenceConfigurationModel struct {
+	MaxTokens     types.Int64          `tfsdk:"max_tokens"`
+	StopSequences fwtypes.ListOfString `tfsdk:"stop_sequences"`
+	Temperature   types.Float64        `tfsdk:"temperature"`
+	TopP          types.Float64        `tfsdk:"top_p"`
+}
+
+type promptMetadataEntryModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type promptTemplateConfigurationModel struct {
+	Chat fwtypes.ListNestedObjectValueOf[chatPromptTemplateConfigurationModel] `tfsdk:"chat"`
+	Text fwtypes.ListNestedObjectValueOf[textPromptTemplateConfigurationModel] `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = promptTemplateConfigurationModel{}
+	_ fwflex.Flattener = &promptTemplateConfigurationModel{}
+)
+
+func (m promptTemplateConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Chat.Elements()) > 0:
+		chatPromptTemplateConfigurationData := fwdiag.Must(m.Chat.ToPtr(ctx))
+		var r awstypes.PromptTemplateConfigurationMemberChat
+		diags.Append(fwflex.Expand(ctx, chatPromptTemplateConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.Text.Elements()) > 0:
+		textPromptTemplateConfigurationData := fwdiag.Must(m.Text.ToPtr(ctx))
+		var r awstypes.PromptTemplateConfigurationMemberText
+		diags.Append(fwflex.Expand(ctx, textPromptTemplateConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptTemplateConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptTemplateConfigurationMemberChat:
+		var model chatPromptTemplateConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Chat = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.PromptTemplateConfigurationMemberText:
+		var model textPromptTemplateConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type chatPromptTemplateConfigurationModel struct {
+	InputVariables    fwtypes.ListNestedObjectValueOf[promptInputVariableModel] `tfsdk:"input_variable"`
+	Messages          fwtypes.ListNestedObjectValueOf[messageModel]             `tfsdk:"message"`
+	System            fwtypes.ListNestedObjectValueOf[systemContentBlockModel]  `tfsdk:"system"`
+	ToolConfiguration fwtypes.ListNestedObjectValueOf[toolConfigurationModel]   `tfsdk:"tool_configuration"`
+}
+
+type textPromptTemplateConfigurationModel struct {
+	CachePoint     fwtypes.ListNestedObjectValueOf[cachePointBlockModel]     `tfsdk:"cache_point"`
+	InputVariables fwtypes.ListNestedObjectValueOf[promptInputVariableModel] `tfsdk:"input_variable"`
+	Text           types.String                                              `tfsdk:"text"`
+}
+
+type promptInputVariableModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type cachePointBlockModel struct {
+	Type fwtypes.StringEnum[awstypes.CachePointType] `tfsdk:"type"`
+}
+
+type messageModel struct {
+	Content fwtypes.ListNestedObjectValueOf[contentBlockModel] `tfsdk:"content"`
+	Role    fwtypes.StringEnum[awstypes.ConversationRole]      `tfsdk:"role"`
+}
+
+type contentBlockModel struct {
+	CachePoint fwtypes.ListNestedObjectValueOf[cachePointBlockModel] `tfsdk:"cache_point"`
+	Text       types.String                                          `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = contentBlockModel{}
+	_ fwflex.Flattener = &contentBlockModel{}
+)
+
+func (m contentBlockModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.CachePoint.Elements()) > 0:
+		cachePointBlockData := fwdiag.Must(m.CachePoint.ToPtr(ctx))
+		var r awstypes.ContentBlockMemberCachePoint
+		diags.Append(fwflex.Expand(ctx, cachePointBlockData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Text.IsNull():
+		return &awstypes.ContentBlockMemberText{
+			Value: m.Text.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *contentBlockModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ContentBlockMemberCachePoint:
+		var model cachePointBlockModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ContentBlockMemberText:
+		m.Text = types.StringValue(t.Value)
+	}
+
+	return diags
+}
+
+type systemContentBlockModel struct {
+	CachePoint fwtypes.ListNestedObjectValueOf[cachePointBlockModel] `tfsdk:"cache_point"`
+	Text       types.String                                          `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = systemContentBlockModel{}
+	_ fwflex.Flattener = &systemContentBlockModel{}
+)
+
+func (m systemContentBlockModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.CachePoint.Elements()) > 0:
+		cachePointBlockData := fwdiag.Must(m.CachePoint.ToPtr(ctx))
+		var r awstypes.SystemContentBlockMemberCachePoint
+		diags.Append(fwflex.Expand(ctx, cachePointBlockData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Text.IsNull():
+		return &awstypes.SystemContentBlockMemberText{
+			Value: m.Text.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *systemContentBlockModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.SystemContentBlockMemberCachePoint:
+		var model cachePointBlockModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.SystemContentBlockMemberText:
+		m.Text = types.StringValue(t.Value)
+	}
+
+	return diags
+}
+
+type toolConfigurationModel struct {
+	ToolChoice fwtypes.ListNestedObjectValueOf[toolChoiceModel] `tfsdk:"tool_choice"`
+	Tools      fwtypes.ListNestedObjectValueOf[toolModel]       `tfsdk:"tool"`
+}
+
+type toolModel struct {
+	CachePoint fwtypes.ListNestedObjectValueOf[cachePointBlockModel]   `tfsdk:"cache_point"`
+	ToolSpec   fwtypes.ListNestedObjectValueOf[toolSpecificationModel] `tfsdk:"tool_spec"`
+}
+
+var (
+	_ fwflex.Expander  = toolModel{}
+	_ fwflex.Flattener = &toolModel{}
+)
+
+func (m toolModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.CachePoint.Elements()) > 0:
+		cachePointBlockData := fwdiag.Must(m.CachePoint.ToPtr(ctx))
+		var r awstypes.ToolMemberCachePoint
+		diags.Append(fwflex.Expand(ctx, cachePointBlockData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case len(m.ToolSpec.Elements()) > 0:
+		toolSpecificationData := fwdiag.Must(m.ToolSpec.ToPtr(ctx))
+		var r awstypes.ToolMemberToolSpec
+		diags.Append(fwflex.Expand(ctx, toolSpecificationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *toolModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ToolMemberCachePoint:
+		var model cachePointBlockModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CachePoint = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.ToolMemberToolSpec:
+		var model toolSpecificationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.ToolSpec = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type toolSpecificationModel struct {
+	Description types.String                                          `tfsdk:"description"`
+	InputSchema fwtypes.ListNestedObjectValueOf[toolInputSchemaModel] `tfsdk:"input_schema"`
+	Name        types.String                                          `tfsdk:"name"`
+}
+
+type toolInputSchemaModel struct {
+	JSON jsontypes.Normalized `tfsdk:"json"`
+}
+
+var (
+	_ fwflex.Expander  = toolInputSchemaModel{}
+	_ fwflex.Flattener = &toolInputSchemaModel{}
+)
+
+func (m toolInputSchemaModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.JSON.IsNull():
+		v, d := expandSmithyDocument(m.JSON)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ToolInputSchemaMemberJson{
+			Value: v,
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *toolInputSchemaModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ToolInputSchemaMemberJson:
+		m.JSON = jsontypes.NewNormalizedNull()
+		if t.Value != nil {
+			b, err := t.Value.MarshalSmithyDocument()
+			if err != nil {
+				diags.AddError("flattening tool input schema", err.Error())
+				return diags
+			}
+
+			m.JSON = jsontypes.NewNormalizedValue(string(b))
+		}
+	}
+
+	return diags
+}
+
+type toolChoiceModel struct {
+	Any  fwtypes.ListNestedObjectValueOf[anyToolChoiceModel]      `tfsdk:"any"`
+	Auto fwtypes.ListNestedObjectValueOf[autoToolChoiceModel]     `tfsdk:"auto"`
+	Tool fwtypes.ListNestedObjectValueOf[specificToolChoiceModel] `tfsdk:"tool"`
+}
+
+var (
+	_ fwflex.Expander  = toolChoiceModel{}
+	_ fwflex.Flattener = &toolChoiceModel{}
+)
+
+func (m toolChoiceModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case len(m.Any.Elements()) > 0:
+		return &awstypes.ToolChoiceMemberAny{}, diags
+
+	case len(m.Auto.Elements()) > 0:
+		return &awstypes.ToolChoiceMemberAuto{}, diags
+
+	case len(m.Tool.Elements()) > 0:
+		specificToolChoiceData := fwdiag.Must(m.Tool.ToPtr(ctx))
+		var r awstypes.ToolChoiceMemberTool
+		diags.Append(fwflex.Expand(ctx, specificToolChoiceData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *toolChoiceModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ToolChoiceMemberAny:
+		m.Any = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &anyToolChoiceModel{})
+
+	case awstypes.ToolChoiceMemberAuto:
+		m.Auto = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &autoToolChoiceModel{})
+
+	case awstypes.ToolChoiceMemberTool:
+		var model specificToolChoiceModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Tool = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type anyToolChoiceModel struct{}
+
+type autoToolChoiceModel struct{}
+
+type specificToolChoiceModel struct {
+	Name types.String `tfsdk:"name"`
+}
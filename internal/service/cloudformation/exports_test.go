@@ -16,6 +16,7 @@ var (
 	FindStackSetByName                      = findStackSetByName
 	FindTypeByARN                           = findTypeByARN
 	FindStackInstancesByNameCallAs          = findStackInstancesByNameCallAs
+	StackSetInstanceImportID                = stackSetInstanceImportID
 	StackSetInstanceResourceIDPartCount     = stackSetInstanceResourceIDPartCount
 	StackInstancesResourceIDPartCount       = stackInstancesResourceIDPartCount
 	TypeVersionARNToTypeARNAndVersionID     = typeVersionARNToTypeARNAndVersionID
@@ -9,9 +9,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]any) []func(*cloudformation.Options) {
@@ -20,7 +22,7 @@ func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]a
 	return []func(*cloudformation.Options){
 		func(o *cloudformation.Options) {
 			o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
-				if errs.IsAErrorMessageContains[*types.OperationInProgressException](err, "Another Operation on StackSet") {
+				if errs.IsAErrorMessageContains[*awstypes.OperationInProgressException](err, "Another Operation on StackSet") {
 					return aws.TrueTernary
 				}
 				return aws.UnknownTernary // Delegate to configured Retryer.
@@ -28,3 +30,73 @@ func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]a
 		},
 	}
 }
+
+// ResourceListers returns the listers of existing CloudFormation resources.
+func (p *servicePackage) ResourceListers(context.Context) []*types.ServicePackageResourceLister {
+	return []*types.ServicePackageResourceLister{
+		{
+			List:     listStackSetInstanceImportIDs,
+			TypeName: "aws_cloudformation_stack_set_instance",
+		},
+	}
+}
+
+// listStackSetInstanceImportIDs returns the import IDs of all instances of all active StackSets.
+// Instances deployed to organizational units are listed once per StackSet, organizational unit and Region.
+func listStackSetInstanceImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+	input := cloudformation.ListStackSetsInput{
+		Status: awstypes.StackSetStatusActive,
+	}
+	var ids []string
+	seen := make(map[string]bool)
+
+	pages := cloudformation.NewListStackSetsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stackSet := range page.Summaries {
+			input := cloudformation.ListStackInstancesInput{
+				StackSetName: stackSet.StackSetName,
+			}
+
+			pages := cloudformation.NewListStackInstancesPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Summaries {
+					id, err := stackSetInstanceImportID(aws.ToString(stackSet.StackSetName), v)
+
+					if err != nil {
+						return nil, err
+					}
+
+					if !seen[id] {
+						seen[id] = true
+						ids = append(ids, id)
+					}
+				}
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// stackSetInstanceImportID returns the import ID of the specified StackSet's instance.
+func stackSetInstanceImportID(stackSetName string, v awstypes.StackInstanceSummary) (string, error) {
+	accountOrOrgID := aws.ToString(v.Account)
+	if v := aws.ToString(v.OrganizationalUnitId); v != "" {
+		accountOrOrgID = v
+	}
+
+	return flex.FlattenResourceId([]string{stackSetName, accountOrOrgID, aws.ToString(v.Region)}, stackSetInstanceResourceIDPartCount, false)
+}
@@ -6,6 +6,7 @@ package cloudformation_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -23,6 +24,65 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestStackSetInstanceImportID(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		summary        awstypes.StackInstanceSummary
+		accountOrOrgID string
+	}{
+		"account": {
+			summary: awstypes.StackInstanceSummary{
+				Account: aws.String("123456789012"),
+				Region:  aws.String("us-west-2"), // lintignore:AWSAT003 // unit test
+			},
+			accountOrOrgID: "123456789012",
+		},
+		"organizational unit": {
+			summary: awstypes.StackInstanceSummary{
+				Account:              aws.String("123456789012"),
+				OrganizationalUnitId: aws.String("ou-abcd-12345678"),
+				Region:               aws.String("us-west-2"), // lintignore:AWSAT003 // unit test
+			},
+			accountOrOrgID: "ou-abcd-12345678",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			id, err := tfcloudformation.StackSetInstanceImportID("test", testcase.summary)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The listed ID must be accepted by the resource's importer and Read.
+			r := tfcloudformation.ResourceStackSetInstance()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			results, err := r.Importer.StateContext(ctx, d, nil)
+			if err != nil {
+				t.Fatalf("unexpected import error: %s", err)
+			}
+			if got, want := len(results), 1; got != want {
+				t.Fatalf("expected %d imported resources, got %d", want, got)
+			}
+
+			parts, err := flex.ExpandResourceId(results[0].Id(), tfcloudformation.StackSetInstanceResourceIDPartCount, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := parts, []string{"test", testcase.accountOrOrgID, "us-west-2"}; !slices.Equal(got, want) { // lintignore:AWSAT003 // unit test
+				t.Errorf("expected ID parts %v, got %v", want, got)
+			}
+		})
+	}
+}
+
 func TestAccCloudFormationStackSetInstance_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackInstance1 awstypes.StackInstance
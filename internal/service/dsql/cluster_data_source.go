@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dsql_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"linked_cluster_arns": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"vpc_endpoint_service_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"witness_region": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := dsql.GetVpcEndpointServiceNameInput{
+		Identifier: output.Identifier,
+	}
+	serviceName, err := conn.GetVpcEndpointServiceName(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Cluster (%s) VPC endpoint service name", id), err.Error())
+
+		return
+	}
+
+	data.Endpoint = types.StringValue(clusterEndpoint(id, d.Meta().Region(ctx)))
+	data.VPCEndpointServiceName = fwflex.StringToFramework(ctx, serviceName.ServiceName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                       types.String                               `tfsdk:"arn"`
+	CreationTime              timetypes.RFC3339                          `tfsdk:"creation_time"`
+	DeletionProtectionEnabled types.Bool                                 `tfsdk:"deletion_protection_enabled"`
+	Endpoint                  types.String                               `tfsdk:"endpoint"`
+	Identifier                types.String                               `tfsdk:"identifier"`
+	LinkedClusterARNs         fwtypes.ListOfString                       `tfsdk:"linked_cluster_arns"`
+	Status                    fwtypes.StringEnum[awstypes.ClusterStatus] `tfsdk:"status"`
+	Tags                      tftags.Map                                 `tfsdk:"tags"`
+	VPCEndpointServiceName    types.String                               `tfsdk:"vpc_endpoint_service_name"`
+	WitnessRegion             types.String                               `tfsdk:"witness_region"`
+}
+
+// clusterEndpoint returns the PostgreSQL endpoint of the specified cluster.
+func clusterEndpoint(id, region string) string {
+	return fmt.Sprintf("%s.dsql.%s.on.aws", id, region)
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+// Exports for use in tests only.
+var (
+	ResourceCluster             = newClusterResource
+	ResourceMultiRegionClusters = newMultiRegionClustersResource
+
+	ClusterParseARN = clusterParseARN
+	FindClusterByID = findClusterByID
+	WithRegion      = withRegion
+)
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dsql_multi_region_clusters", name="Multi-Region Clusters")
+func newMultiRegionClustersResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &multiRegionClustersResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type multiRegionClustersResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *multiRegionClustersResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"linked_cluster_arns": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"linked_region_list": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Required:   true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+					listvalidator.UniqueValues(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"witness_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *multiRegionClustersResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	regions := fwflex.ExpandFrameworkStringValueList(ctx, data.LinkedRegionList)
+	input := dsql.CreateMultiRegionClustersInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		LinkedRegionList: regions,
+		WitnessRegion:    fwflex.StringFromFramework(ctx, data.WitnessRegion),
+	}
+	if !data.DeletionProtectionEnabled.IsUnknown() {
+		input.ClusterProperties = make(map[string]awstypes.LinkedClusterProperties, len(regions))
+		for _, region := range regions {
+			input.ClusterProperties[region] = awstypes.LinkedClusterProperties{
+				DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+			}
+		}
+	}
+
+	output, err := conn.CreateMultiRegionClusters(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating DSQL Multi-Region Clusters", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.LinkedClusterARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.LinkedClusterArns)
+	data.ID = types.StringValue(multiRegionClustersCreateResourceID(output.LinkedClusterArns))
+
+	var deletionProtectionEnabled *bool
+	for _, v := range output.LinkedClusterArns {
+		region, id, err := clusterParseARN(v)
+		if err != nil {
+			response.Diagnostics.AddError("creating DSQL Multi-Region Clusters", err.Error())
+
+			return
+		}
+
+		cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts), withRegion(region))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) create", v), err.Error())
+
+			return
+		}
+
+		deletionProtectionEnabled = cluster.DeletionProtectionEnabled
+	}
+
+	data.DeletionProtectionEnabled = fwflex.BoolToFramework(ctx, deletionProtectionEnabled)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *multiRegionClustersResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	arns := multiRegionClustersParseResourceID(data.ID.ValueString())
+	var regions []string
+	var cluster *dsql.GetClusterOutput
+
+	for _, v := range arns {
+		region, id, err := clusterParseARN(v)
+		if err != nil {
+			response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+			return
+		}
+
+		// The clusters are only usable as a set, so any missing cluster removes the resource.
+		output, err := findClusterByID(ctx, conn, id, withRegion(region))
+
+		if tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Cluster (%s)", v), err.Error())
+
+			return
+		}
+
+		regions = append(regions, region)
+		if cluster == nil {
+			cluster = output
+		}
+	}
+
+	data.DeletionProtectionEnabled = fwflex.BoolToFramework(ctx, cluster.DeletionProtectionEnabled)
+	data.LinkedClusterARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, arns)
+	data.WitnessRegion = fwflex.StringToFramework(ctx, cluster.WitnessRegion)
+
+	// Set attributes for import.
+	if data.LinkedRegionList.IsNull() {
+		data.LinkedRegionList = fwflex.FlattenFrameworkStringValueListOfString(ctx, regions)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *multiRegionClustersResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) {
+		for _, v := range multiRegionClustersParseResourceID(new.ID.ValueString()) {
+			region, id, err := clusterParseARN(v)
+			if err != nil {
+				response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+				return
+			}
+
+			input := dsql.UpdateClusterInput{
+				ClientToken:               aws.String(sdkid.UniqueId()),
+				DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+				Identifier:                aws.String(id),
+			}
+
+			_, err = conn.UpdateCluster(ctx, &input, withRegion(region))
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating DSQL Cluster (%s)", v), err.Error())
+
+				return
+			}
+
+			if _, err := waitClusterUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts), withRegion(region)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) update", v), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *multiRegionClustersResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	arns := multiRegionClustersParseResourceID(data.ID.ValueString())
+	input := dsql.DeleteMultiRegionClustersInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		LinkedClusterArns: arns,
+	}
+	_, err := conn.DeleteMultiRegionClusters(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DSQL Multi-Region Clusters (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	for _, v := range arns {
+		region, id, err := clusterParseARN(v)
+		if err != nil {
+			response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+			return
+		}
+
+		if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts), withRegion(region)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) delete", v), err.Error())
+
+			return
+		}
+	}
+}
+
+type multiRegionClustersResourceModel struct {
+	DeletionProtectionEnabled types.Bool           `tfsdk:"deletion_protection_enabled"`
+	ID                        types.String         `tfsdk:"id"`
+	LinkedClusterARNs         fwtypes.ListOfString `tfsdk:"linked_cluster_arns"`
+	LinkedRegionList          fwtypes.ListOfString `tfsdk:"linked_region_list"`
+	Timeouts                  timeouts.Value       `tfsdk:"timeouts"`
+	WitnessRegion             types.String         `tfsdk:"witness_region"`
+}
+
+// The resource ID is the comma-separated list of linked cluster ARNs.
+func multiRegionClustersCreateResourceID(arns []string) string {
+	return strings.Join(arns, flex.ResourceIdSeparator)
+}
+
+func multiRegionClustersParseResourceID(id string) []string {
+	return strings.Split(id, flex.ResourceIdSeparator)
+}
+
+// clusterParseARN returns the Region and identifier of the cluster with the specified ARN.
+func clusterParseARN(v string) (string, string, error) {
+	arn, err := arn.Parse(v)
+	if err != nil {
+		return "", "", err
+	}
+
+	id, ok := strings.CutPrefix(arn.Resource, "cluster/")
+	if !ok || id == "" {
+		return "", "", fmt.Errorf("unexpected format for DSQL Cluster ARN (%s)", v)
+	}
+
+	return arn.Region, id, nil
+}
+
+// withRegion returns a functional option that sends API requests to the specified Region.
+func withRegion(region string) func(*dsql.Options) {
+	return func(o *dsql.Options) {
+		o.Region = region
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfdsql "github.com/hashicorp/terraform-provider-aws/internal/service/dsql"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLMultiRegionClusters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dsql_multi_region_clusters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DSQL)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionClustersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionClustersConfig_basic(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linked_cluster_arns.#", "2"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("witness_region"), knownvalue.StringExact(acctest.ThirdRegion())),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"linked_region_list", names.AttrTimeouts},
+			},
+			{
+				Config: testAccMultiRegionClustersConfig_basic(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccMultiRegionClustersConfig_basic(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionClustersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSQLClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dsql_multi_region_clusters" {
+				continue
+			}
+
+			for _, v := range strings.Split(rs.Primary.ID, flex.ResourceIdSeparator) {
+				region, id, err := tfdsql.ClusterParseARN(v)
+
+				if err != nil {
+					return err
+				}
+
+				_, err = tfdsql.FindClusterByID(ctx, conn, id, tfdsql.WithRegion(region))
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DSQL Cluster %s still exists", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMultiRegionClustersExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSQLClient(ctx)
+
+		for _, v := range strings.Split(rs.Primary.ID, flex.ResourceIdSeparator) {
+			region, id, err := tfdsql.ClusterParseARN(v)
+
+			if err != nil {
+				return err
+			}
+
+			if _, err := tfdsql.FindClusterByID(ctx, conn, id, tfdsql.WithRegion(region)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccMultiRegionClustersConfig_basic(deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_dsql_multi_region_clusters" "test" {
+  linked_region_list          = [%[1]q, %[2]q]
+  witness_region              = %[3]q
+  deletion_protection_enabled = %[4]t
+}
+`, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion(), deletionProtection)
+}
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointAssociations,DescribeVpcEndpointServices"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointAssociations,DescribeVpcEndpointServices,GetRouteServerRoutingDatabase"; DO NOT EDIT.
 
 package ec2
 
@@ -89,3 +89,19 @@ func describeVPCEndpointServicesPages(ctx context.Context, conn *ec2.Client, inp
 	}
 	return nil
 }
+func getRouteServerRoutingDatabasePages(ctx context.Context, conn *ec2.Client, input *ec2.GetRouteServerRoutingDatabaseInput, fn func(*ec2.GetRouteServerRoutingDatabaseOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRouteServerRoutingDatabase(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServerPeer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RouteServerPeer
+	resourceName := "aws_vpc_route_server_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName, string(awstypes.RouteServerPeerLivenessModeBgpKeepalive)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server-peer/rsp-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_asn", "65000"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_liveness_detection", string(awstypes.RouteServerPeerLivenessModeBgpKeepalive)),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_eni_address", "aws_vpc_route_server_endpoint.test", "eni_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_eni_id", "aws_vpc_route_server_endpoint.test", "eni_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_server_endpoint_id", "aws_vpc_route_server_endpoint.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "route_server_id", "aws_vpc_route_server.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSubnetID, "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServerPeer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RouteServerPeer
+	resourceName := "aws_vpc_route_server_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName, string(awstypes.RouteServerPeerLivenessModeBgpKeepalive)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServerPeer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServerPeer_bfd(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.RouteServerPeer
+	resourceName := "aws_vpc_route_server_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName, string(awstypes.RouteServerPeerLivenessModeBgpKeepalive)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName, string(awstypes.RouteServerPeerLivenessModeBfd)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName, &v2),
+					testAccCheckVPCRouteServerPeerRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_liveness_detection", string(awstypes.RouteServerPeerLivenessModeBfd)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerPeerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server_peer" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerPeerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server Peer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerPeerExists(ctx context.Context, n string, v *awstypes.RouteServerPeer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindRouteServerPeerByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerPeerRecreated(before, after *awstypes.RouteServerPeer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.RouteServerPeerId), aws.ToString(after.RouteServerPeerId); before == after {
+			return fmt.Errorf("VPC Route Server Peer (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCRouteServerPeerConfig_basic(rName, livenessDetection string) string {
+	return acctest.ConfigCompose(testAccVPCRouteServerEndpointConfig_basic(rName), fmt.Sprintf(`
+resource "aws_vpc_route_server_peer" "test" {
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test.id
+  peer_address             = cidrhost(aws_subnet.test[0].cidr_block, 10)
+
+  bgp_options {
+    peer_asn                = 65000
+    peer_liveness_detection = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, livenessDetection))
+}
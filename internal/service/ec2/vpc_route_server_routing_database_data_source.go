@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_vpc_route_server_routing_database", name="VPC Route Server Routing Database")
+func newVPCRouteServerRoutingDatabaseDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &vpcRouteServerRoutingDatabaseDataSource{}, nil
+}
+
+type vpcRouteServerRoutingDatabaseDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *vpcRouteServerRoutingDatabaseDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"are_routes_persisted": schema.BoolAttribute{
+				Computed: true,
+			},
+			"route_server_id": schema.StringAttribute{
+				Required: true,
+			},
+			"routes": framework.DataSourceComputedListOfObjectAttribute[routeServerRouteModel](ctx),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: customFiltersBlock(),
+		},
+	}
+}
+
+func (d *vpcRouteServerRoutingDatabaseDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vpcRouteServerRoutingDatabaseDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	input := ec2.GetRouteServerRoutingDatabaseInput{
+		Filters:       newCustomFilterListFramework(ctx, data.Filters),
+		RouteServerId: fwflex.StringFromFramework(ctx, data.RouteServerID),
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	output, persisted, err := findRouteServerRoutingDatabase(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Route Server (%s) Routing Database", data.RouteServerID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AreRoutesPersisted = types.BoolValue(persisted)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Routes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type vpcRouteServerRoutingDatabaseDataSourceModel struct {
+	AreRoutesPersisted types.Bool                                             `tfsdk:"are_routes_persisted"`
+	Filters            types.Set                                              `tfsdk:"filter"`
+	RouteServerID      types.String                                           `tfsdk:"route_server_id"`
+	Routes             fwtypes.ListNestedObjectValueOf[routeServerRouteModel] `tfsdk:"routes"`
+}
+
+type routeServerRouteModel struct {
+	ASPaths                  fwtypes.ListValueOf[types.String]                                        `tfsdk:"as_paths"`
+	MED                      types.Int64                                                              `tfsdk:"med"`
+	NextHopIP                types.String                                                             `tfsdk:"next_hop_ip"`
+	Prefix                   types.String                                                             `tfsdk:"prefix"`
+	RouteInstallationDetails fwtypes.ListNestedObjectValueOf[routeServerRouteInstallationDetailModel] `tfsdk:"route_installation_details"`
+	RouteServerEndpointID    types.String                                                             `tfsdk:"route_server_endpoint_id"`
+	RouteServerPeerID        types.String                                                             `tfsdk:"route_server_peer_id"`
+	RouteStatus              fwtypes.StringEnum[awstypes.RouteServerRouteStatus]                      `tfsdk:"route_status"`
+}
+
+type routeServerRouteInstallationDetailModel struct {
+	RouteInstallationStatus       fwtypes.StringEnum[awstypes.RouteServerRouteInstallationStatus] `tfsdk:"route_installation_status"`
+	RouteInstallationStatusReason types.String                                                    `tfsdk:"route_installation_status_reason"`
+	RouteTableID                  types.String                                                    `tfsdk:"route_table_id"`
+}
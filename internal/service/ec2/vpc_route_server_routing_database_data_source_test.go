@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServerRoutingDatabaseDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_route_server_routing_database.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerRoutingDatabaseDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "are_routes_persisted", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_server_id", "aws_vpc_route_server.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCRouteServerRoutingDatabaseDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteServerPeerConfig_basic(rName, "bgp-keepalive"), `
+data "aws_vpc_route_server_routing_database" "test" {
+  route_server_id = aws_vpc_route_server_peer.test.route_server_id
+
+  filter {
+    name   = "route-status"
+    values = ["in-rib"]
+  }
+}
+`)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecr_images", name="Images")
+func newImagesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &imagesDataSource{}, nil
+}
+
+type imagesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *imagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"images":     framework.DataSourceComputedListOfObjectAttribute[imageDetailModel](ctx),
+			"pushed_after": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrRepositoryName: schema.StringAttribute{
+				Required: true,
+			},
+			"tag_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TagStatus](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *imagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data imagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECRClient(ctx)
+
+	repositoryName := data.RepositoryName.ValueString()
+	input := &ecr.DescribeImagesInput{
+		RegistryId:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+	if !data.TagStatus.IsNull() {
+		input.Filter = &awstypes.DescribeImagesFilter{
+			TagStatus: data.TagStatus.ValueEnum(),
+		}
+	}
+
+	filter := tfslices.PredicateTrue[awstypes.ImageDetail]()
+	if !data.PushedAfter.IsNull() {
+		pushedAfter, diags := data.PushedAfter.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		filter = func(v awstypes.ImageDetail) bool {
+			return aws.ToTime(v.ImagePushedAt).After(pushedAfter)
+		}
+	}
+
+	output, err := findImages(ctx, conn, input, filter)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Images (%s)", repositoryName), err.Error())
+
+		return
+	}
+
+	// Most recently pushed first.
+	slices.SortStableFunc(output, func(a, b awstypes.ImageDetail) int {
+		return aws.ToTime(b.ImagePushedAt).Compare(aws.ToTime(a.ImagePushedAt))
+	})
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Images)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, repositoryName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findImages(ctx context.Context, conn *ecr.Client, input *ecr.DescribeImagesInput, filter tfslices.Predicate[awstypes.ImageDetail]) ([]awstypes.ImageDetail, error) {
+	var output []awstypes.ImageDetail
+
+	err := describeImagesPages(ctx, conn, input, func(page *ecr.DescribeImagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ImageDetails {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.RepositoryNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type imagesDataSourceModel struct {
+	ID             types.String                                      `tfsdk:"id"`
+	Images         fwtypes.ListNestedObjectValueOf[imageDetailModel] `tfsdk:"images"`
+	PushedAfter    timetypes.RFC3339                                 `tfsdk:"pushed_after"`
+	RegistryID     types.String                                      `tfsdk:"registry_id"`
+	RepositoryName types.String                                      `tfsdk:"repository_name"`
+	TagStatus      fwtypes.StringEnum[awstypes.TagStatus]            `tfsdk:"tag_status"`
+}
+
+type imageDetailModel struct {
+	ImageDigest      types.String         `tfsdk:"image_digest"`
+	ImagePushedAt    timetypes.RFC3339    `tfsdk:"image_pushed_at"`
+	ImageSizeInBytes types.Int64          `tfsdk:"image_size_in_bytes"`
+	ImageTags        fwtypes.ListOfString `tfsdk:"image_tags"`
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+	imageDataSourceName := "data.aws_ecr_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_basic(registry, repo),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "images.#", 1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_digest", imageDataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_pushed_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_size_in_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_tagStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_tagStatus(registry, repo, "TAGGED"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "images.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_tags.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_pushedAfter(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_pushedAfter(registry, repo, "2999-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_basic(reg, repo string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+}
+
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  most_recent     = true
+}
+`, reg, repo)
+}
+
+func testAccImagesDataSourceConfig_tagStatus(reg, repo, tagStatus string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_status      = %[3]q
+}
+`, reg, repo, tagStatus)
+}
+
+func testAccImagesDataSourceConfig_pushedAfter(reg, repo, pushedAfter string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  pushed_after    = %[3]q
+}
+`, reg, repo, pushedAfter)
+}
@@ -27,6 +27,7 @@ var (
 	RuleEventPatternJSONDecoder = ruleEventPatternJSONDecoder
 	RuleCreateResourceID        = ruleCreateResourceID
 	RuleParseResourceID         = ruleParseResourceID
+	TargetCreateImportID        = targetCreateImportID
 	TargetParseImportID         = targetParseImportID
 	TargetStateUpgradeV0        = targetStateUpgradeV0
 )
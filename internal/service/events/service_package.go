@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourceListers returns the listers of existing EventBridge resources.
+func (p *servicePackage) ResourceListers(context.Context) []*types.ServicePackageResourceLister {
+	return []*types.ServicePackageResourceLister{
+		{
+			List:     listBusImportIDs,
+			TypeName: "aws_cloudwatch_event_bus",
+		},
+		{
+			List:     listRuleImportIDs,
+			TypeName: "aws_cloudwatch_event_rule",
+		},
+		{
+			List:     listTargetImportIDs,
+			TypeName: "aws_cloudwatch_event_target",
+		},
+	}
+}
+
+// listBusImportIDs returns the import IDs of all custom event buses.
+// The default event bus cannot be managed.
+func listBusImportIDs(ctx context.Context, meta any) ([]string, error) {
+	var ids []string
+
+	err := walkEventBuses(ctx, meta.(*conns.AWSClient).EventsClient(ctx), func(eventBusName string) error {
+		if eventBusName != DefaultEventBusName {
+			ids = append(ids, eventBusName)
+		}
+		return nil
+	})
+
+	return ids, err
+}
+
+// listRuleImportIDs returns the import IDs of all rules on all event buses.
+// Rules managed by other AWS services are excluded.
+func listRuleImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+	var ids []string
+
+	err := walkEventBuses(ctx, conn, func(eventBusName string) error {
+		return walkRules(ctx, conn, eventBusName, func(ruleName string) error {
+			ids = append(ids, ruleCreateResourceID(eventBusName, ruleName))
+			return nil
+		})
+	})
+
+	return ids, err
+}
+
+// listTargetImportIDs returns the import IDs of all targets of all rules on all event buses.
+// Targets of rules managed by other AWS services are excluded.
+func listTargetImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+	var ids []string
+
+	err := walkEventBuses(ctx, conn, func(eventBusName string) error {
+		return walkRules(ctx, conn, eventBusName, func(ruleName string) error {
+			input := &eventbridge.ListTargetsByRuleInput{
+				EventBusName: aws.String(eventBusName),
+				Rule:         aws.String(ruleName),
+			}
+
+			return listTargetsByRulePages(ctx, conn, input, func(page *eventbridge.ListTargetsByRuleOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Targets {
+					ids = append(ids, targetCreateImportID(eventBusName, ruleName, aws.ToString(v.Id)))
+				}
+
+				return !lastPage
+			})
+		})
+	})
+
+	return ids, err
+}
+
+func walkEventBuses(ctx context.Context, conn *eventbridge.Client, fn func(string) error) error {
+	input := &eventbridge.ListEventBusesInput{}
+	var err error
+
+	pagesErr := listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EventBuses {
+			if err = fn(aws.ToString(v.Name)); err != nil {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if pagesErr != nil {
+		return pagesErr
+	}
+
+	return err
+}
+
+func walkRules(ctx context.Context, conn *eventbridge.Client, eventBusName string, fn func(string) error) error {
+	input := &eventbridge.ListRulesInput{
+		EventBusName: aws.String(eventBusName),
+	}
+	var err error
+
+	pagesErr := listRulesPages(ctx, conn, input, func(page *eventbridge.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Rules {
+			if aws.ToString(v.ManagedBy) != "" {
+				continue
+			}
+
+			if err = fn(aws.ToString(v.Name)); err != nil {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if pagesErr != nil {
+		return pagesErr
+	}
+
+	return err
+}
@@ -762,6 +762,15 @@ const (
 )
 
 func targetCreateResourceID(eventBusName, ruleName, targetID string) string {
+	return targetJoinID(targetResourceIDSeparator, eventBusName, ruleName, targetID)
+}
+
+// targetCreateImportID returns the ID accepted by the resource's importer, i.e. parsed by targetParseImportID.
+func targetCreateImportID(eventBusName, ruleName, targetID string) string {
+	return targetJoinID(targetImportIDSeparator, eventBusName, ruleName, targetID)
+}
+
+func targetJoinID(separator, eventBusName, ruleName, targetID string) string {
 	var parts []string
 
 	if eventBusName == "" || eventBusName == DefaultEventBusName {
@@ -770,7 +779,7 @@ func targetCreateResourceID(eventBusName, ruleName, targetID string) string {
 		parts = []string{eventBusName, ruleName, targetID}
 	}
 
-	id := strings.Join(parts, targetResourceIDSeparator)
+	id := strings.Join(parts, separator)
 
 	return id
 }
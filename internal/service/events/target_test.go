@@ -190,6 +190,69 @@ func TestTargetParseImportID(t *testing.T) {
 	}
 }
 
+func TestTargetCreateImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName     string
+		EventBusName string
+		RuleName     string
+		TargetID     string
+		ExpectedID   string
+	}{
+		{
+			TestName:     "default event bus",
+			EventBusName: tfevents.DefaultEventBusName,
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "TestRule/TestTarget",
+		},
+		{
+			TestName:     "custom event bus",
+			EventBusName: "TestEventBus",
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "TestEventBus/TestRule/TestTarget",
+		},
+		{
+			TestName:     "partner event bus",
+			EventBusName: "aws.partner/example.com/Test",
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "aws.partner/example.com/Test/TestRule/TestTarget",
+		},
+		{
+			TestName:     "ARN event bus",
+			EventBusName: "arn:aws:events:us-east-2:123456789012:event-bus/TestEventBus", //lintignore:AWSAT003,AWSAT005
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "arn:aws:events:us-east-2:123456789012:event-bus/TestEventBus/TestRule/TestTarget", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfevents.TargetCreateImportID(testCase.EventBusName, testCase.RuleName, testCase.TargetID)
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedID)
+			}
+
+			eventBusName, ruleName, targetID, err := tfevents.TargetParseImportID(got)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if eventBusName != testCase.EventBusName || ruleName != testCase.RuleName || targetID != testCase.TargetID {
+				t.Errorf("got (%s, %s, %s), expected (%s, %s, %s)", eventBusName, ruleName, targetID, testCase.EventBusName, testCase.RuleName, testCase.TargetID)
+			}
+		})
+	}
+}
+
 func TestAccEventsTarget_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Target
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+)
+
+// ConcurrencyLimit returns the default maximum number of in-flight mutating IAM API calls.
+// IAM is a global service with account-wide request quotas that are shared by all Regions.
+func (p *servicePackage) ConcurrencyLimit(context.Context) int {
+	return 10
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/invoicing/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_invoicing_invoice_units", name="Invoice Units")
+// @Region(global=true)
+func newInvoiceUnitsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &invoiceUnitsDataSource{}, nil
+}
+
+type invoiceUnitsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *invoiceUnitsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"invoice_units": framework.DataSourceComputedListOfObjectAttribute[invoiceUnitModel](ctx),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[invoiceUnitsFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"invoice_receivers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrNames: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *invoiceUnitsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data invoiceUnitsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().InvoicingClient(ctx)
+
+	var input invoicing.ListInvoiceUnitsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findInvoiceUnits(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Invoicing Invoice Units", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.InvoiceUnits, fwflex.WithFieldNamePrefix("InvoiceUnit"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type invoiceUnitsDataSourceModel struct {
+	Filters      fwtypes.ListNestedObjectValueOf[invoiceUnitsFilterModel] `tfsdk:"filter"`
+	InvoiceUnits fwtypes.ListNestedObjectValueOf[invoiceUnitModel]        `tfsdk:"invoice_units"`
+}
+
+type invoiceUnitsFilterModel struct {
+	Accounts         fwtypes.SetOfString `tfsdk:"accounts"`
+	InvoiceReceivers fwtypes.SetOfString `tfsdk:"invoice_receivers"`
+	Names            fwtypes.SetOfString `tfsdk:"names"`
+}
+
+type invoiceUnitModel struct {
+	ARN                    types.String                                          `tfsdk:"arn"`
+	Description            types.String                                          `tfsdk:"description"`
+	InvoiceReceiver        types.String                                          `tfsdk:"invoice_receiver"`
+	LastModified           timetypes.RFC3339                                     `tfsdk:"last_modified"`
+	Name                   types.String                                          `tfsdk:"name"`
+	Rule                   fwtypes.ListNestedObjectValueOf[invoiceUnitRuleModel] `tfsdk:"rule"`
+	TaxInheritanceDisabled types.Bool                                            `tfsdk:"tax_inheritance_disabled"`
+}
+
+func findInvoiceUnits(ctx context.Context, conn *invoicing.Client, input *invoicing.ListInvoiceUnitsInput) ([]awstypes.InvoiceUnit, error) {
+	var output []awstypes.InvoiceUnit
+
+	pages := invoicing.NewListInvoiceUnitsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InvoiceUnits...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInvoicingInvoiceUnitsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_invoicing_invoice_unit.test"
+	dataSource1Name := "data.aws_invoicing_invoice_units.by_name"
+	dataSource2Name := "data.aws_invoicing_invoice_units.all"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Invoicing)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.InvoicingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInvoiceUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUnitsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "invoice_units.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "invoice_units.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "invoice_units.0.invoice_receiver", resourceName, "invoice_receiver"),
+					resource.TestCheckResourceAttrSet(dataSource1Name, "invoice_units.0.last_modified"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "invoice_units.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSource1Name, "invoice_units.0.rule.#", "1"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSource2Name, "invoice_units.#", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvoiceUnitsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInvoiceUnitConfig_basic(rName), `
+data "aws_invoicing_invoice_units" "by_name" {
+  filter {
+    names = [aws_invoicing_invoice_unit.test.name]
+  }
+}
+
+data "aws_invoicing_invoice_units" "all" {
+  depends_on = [aws_invoicing_invoice_unit.test]
+}
+`)
+}
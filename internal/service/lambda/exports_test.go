@@ -17,24 +17,26 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
-	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
-	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
-	FindEventSourceMappingByID                   = findEventSourceMappingByID
-	FindFunctionByName                           = findFunctionByName
-	FindFunctionEventInvokeConfigByTwoPartKey    = findFunctionEventInvokeConfigByTwoPartKey
-	FindFunctionRecursionConfigByName            = findFunctionRecursionConfigByName
-	FindFunctionURLByTwoPartKey                  = findFunctionURLByTwoPartKey
-	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
-	FindLayerVersionPolicyByTwoPartKey           = findLayerVersionPolicyByTwoPartKey
-	FindPolicyStatementByTwoPartKey              = findPolicyStatementByTwoPartKey
-	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
-	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
-	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
-	GetFunctionNameFromARN                       = getFunctionNameFromARN
-	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
-	LayerVersionParseResourceID                  = layerVersionParseResourceID
-	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
-	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	FindAliasByTwoPartKey                           = findAliasByTwoPartKey
+	FindCodeSigningConfigByARN                      = findCodeSigningConfigByARN
+	FindEventSourceMappingByID                      = findEventSourceMappingByID
+	FindFunctionByName                              = findFunctionByName
+	FindFunctionEventInvokeConfigByTwoPartKey       = findFunctionEventInvokeConfigByTwoPartKey
+	FindFunctionRecursionConfigByName               = findFunctionRecursionConfigByName
+	FindFunctionURLByTwoPartKey                     = findFunctionURLByTwoPartKey
+	FindLayerVersionByTwoPartKey                    = findLayerVersionByTwoPartKey
+	FindLayerVersionPolicyByTwoPartKey              = findLayerVersionPolicyByTwoPartKey
+	FindPolicyStatementByTwoPartKey                 = findPolicyStatementByTwoPartKey
+	FindProvisionedConcurrencyConfigByTwoPartKey    = findProvisionedConcurrencyConfigByTwoPartKey
+	FindRuntimeManagementConfigByTwoPartKey         = findRuntimeManagementConfigByTwoPartKey
+	FunctionEventInvokeConfigParseResourceID        = functionEventInvokeConfigParseResourceID
+	GetFunctionNameFromARN                          = getFunctionNameFromARN
+	GetQualifierFromAliasOrVersionARN               = getQualifierFromAliasOrVersionARN
+	LayerVersionParseResourceID                     = layerVersionParseResourceID
+	LayerVersionPermissionParseResourceID           = layerVersionPermissionParseResourceID
+	ProvisionedConcurrencyConfigImportID            = provisionedConcurrencyConfigImportID
+	ProvisionedConcurrencyConfigResourceIDPartCount = provisionedConcurrencyConfigResourceIDPartCount
+	SignerServiceIsAvailable                        = signerServiceIsAvailable
 
 	ValidFunctionName               = validFunctionName
 	ValidPermissionAction           = validPermissionAction
@@ -8,16 +8,57 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestProvisionedConcurrencyConfigImportID(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	v := awstypes.ProvisionedConcurrencyConfigListItem{
+		FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:lambda_function_name:testalias"), // lintignore:AWSAT003,AWSAT005 // unit test
+	}
+
+	id, err := tflambda.ProvisionedConcurrencyConfigImportID("lambda_function_name", v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The listed ID must be accepted by the resource's importer and Read.
+	r := tflambda.ResourceProvisionedConcurrencyConfig()
+	d := r.Data(nil)
+	d.SetId(id)
+
+	results, err := r.Importer.StateContext(ctx, d, nil)
+	if err != nil {
+		t.Fatalf("unexpected import error: %s", err)
+	}
+	if got, want := len(results), 1; got != want {
+		t.Fatalf("expected %d imported resources, got %d", want, got)
+	}
+
+	parts, err := flex.ExpandResourceId(results[0].Id(), tflambda.ProvisionedConcurrencyConfigResourceIDPartCount, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := parts[0], "lambda_function_name"; got != want {
+		t.Errorf("expected function name %q, got %q", want, got)
+	}
+	if got, want := parts[1], "testalias"; got != want {
+		t.Errorf("expected qualifier %q, got %q", want, got)
+	}
+}
+
 func TestAccLambdaProvisionedConcurrencyConfig_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
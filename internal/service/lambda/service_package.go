@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourceListers returns the listers of existing Lambda resources.
+func (p *servicePackage) ResourceListers(context.Context) []*types.ServicePackageResourceLister {
+	return []*types.ServicePackageResourceLister{
+		{
+			List:     listProvisionedConcurrencyConfigImportIDs,
+			TypeName: "aws_lambda_provisioned_concurrency_config",
+		},
+	}
+}
+
+// listProvisionedConcurrencyConfigImportIDs returns the import IDs of all provisioned concurrency configurations of all functions.
+func listProvisionedConcurrencyConfigImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+	var ids []string
+
+	pages := lambda.NewListFunctionsPaginator(conn, &lambda.ListFunctionsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, function := range page.Functions {
+			input := &lambda.ListProvisionedConcurrencyConfigsInput{
+				FunctionName: function.FunctionName,
+			}
+
+			pages := lambda.NewListProvisionedConcurrencyConfigsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.ProvisionedConcurrencyConfigs {
+					id, err := provisionedConcurrencyConfigImportID(aws.ToString(function.FunctionName), v)
+
+					if err != nil {
+						return nil, err
+					}
+
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// provisionedConcurrencyConfigImportID returns the import ID of the specified function's provisioned concurrency configuration.
+func provisionedConcurrencyConfigImportID(functionName string, v awstypes.ProvisionedConcurrencyConfigListItem) (string, error) {
+	qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(v.FunctionArn))
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId([]string{functionName, qualifier}, provisionedConcurrencyConfigResourceIDPartCount, false)
+}
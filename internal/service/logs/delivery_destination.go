@@ -13,7 +13,6 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -33,6 +32,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_destination", name="Delivery Destination")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
 func newDeliveryDestinationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliveryDestinationResource{}
@@ -229,10 +229,6 @@ func (r *deliveryDestinationResource) Delete(ctx context.Context, request resour
 	}
 }
 
-func (r *deliveryDestinationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
-}
-
 func findDeliveryDestinationByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*awstypes.DeliveryDestination, error) {
 	input := cloudwatchlogs.GetDeliveryDestinationInput{
 		Name: aws.String(name),
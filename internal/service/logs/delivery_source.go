@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,6 +30,7 @@ import (
 
 // @FrameworkResource("aws_cloudwatch_log_delivery_source", name="Delivery Source")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
 func newDeliverySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &deliverySourceResource{}
@@ -185,10 +185,6 @@ func (r *deliverySourceResource) Delete(ctx context.Context, request resource.De
 	}
 }
 
-func (r *deliverySourceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
-}
-
 func findDeliverySourceByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*awstypes.DeliverySource, error) {
 	input := cloudwatchlogs.GetDeliverySourceInput{
 		Name: aws.String(name),
@@ -16,11 +16,13 @@ var (
 	ResourceDestinationPolicy         = resourceDestinationPolicy
 	ResourceGroup                     = resourceGroup
 	ResourceIndexPolicy               = newIndexPolicyResource
+	ResourceIntegration               = newIntegrationResource
 	ResourceMetricFilter              = resourceMetricFilter
 	ResourceQueryDefinition           = resourceQueryDefinition
 	ResourceResourcePolicy            = resourceResourcePolicy
 	ResourceStream                    = resourceStream
 	ResourceSubscriptionFilter        = resourceSubscriptionFilter
+	ResourceTransformer               = newTransformerResource
 
 	FindAccountPolicyByTwoPartKey                          = findAccountPolicyByTwoPartKey
 	FindDataProtectionPolicyByLogGroupName                 = findDataProtectionPolicyByLogGroupName
@@ -31,6 +33,7 @@ var (
 	FindDestinationByName                                  = findDestinationByName
 	FindDestinationPolicyByName                            = findDestinationPolicyByName
 	FindIndexPolicyByLogGroupName                          = findIndexPolicyByLogGroupName
+	FindIntegrationByName                                  = findIntegrationByName
 	FindLogAnomalyDetectorByARN                            = findLogAnomalyDetectorByARN
 	FindLogGroupByName                                     = findLogGroupByName
 	FindLogStreamByTwoPartKey                              = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
@@ -38,6 +41,7 @@ var (
 	FindQueryDefinitionByTwoPartKey                        = findQueryDefinitionByTwoPartKey
 	FindResourcePolicyByName                               = findResourcePolicyByName
 	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
+	FindTransformerByLogGroupIdentifier                    = findTransformerByLogGroupIdentifier
 
 	TrimLogGroupARNWildcardSuffix          = trimLogGroupARNWildcardSuffix
 	ValidLogGroupName                      = validLogGroupName
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudwatch_log_integration", name="Integration")
+func newIntegrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &integrationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type integrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *integrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"integration_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			"integration_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationStatus](),
+				Computed:   true,
+			},
+			"integration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"opensearch_resource_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[openSearchResourceConfigModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+									"dashboard_viewer_principals": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"data_source_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrKMSKeyARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+									"retention_days": schema.Int32Attribute{
+										Required: true,
+										Validators: []validator.Int32{
+											int32validator.Between(1, 3650),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *integrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	var input cloudwatchlogs.PutIntegrationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.IntegrationName.ValueString()
+	_, err := conn.PutIntegration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := waitIntegrationActive(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("integration_name"), name) // Set 'integration_name' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Logs Integration (%s) create", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IntegrationStatus = fwtypes.StringEnumValue(output.IntegrationStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	output, err := findIntegrationByName(ctx, conn, data.IntegrationName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Integration (%s)", data.IntegrationName.ValueString()), err.Error())
+
+		return
+	}
+
+	// The API doesn't return the resource configuration.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	name := data.IntegrationName.ValueString()
+	input := cloudwatchlogs.DeleteIntegrationInput{
+		IntegrationName: fwflex.StringFromFramework(ctx, data.IntegrationName),
+	}
+	_, err := conn.DeleteIntegration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Logs Integration (%s) delete", name), err.Error())
+
+		return
+	}
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("integration_name"), request, response)
+}
+
+func findIntegrationByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*cloudwatchlogs.GetIntegrationOutput, error) {
+	input := cloudwatchlogs.GetIntegrationInput{
+		IntegrationName: &name,
+	}
+
+	return findIntegration(ctx, conn, &input)
+}
+
+func findIntegration(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.GetIntegrationInput) (*cloudwatchlogs.GetIntegrationOutput, error) {
+	output, err := conn.GetIntegration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIntegration(ctx context.Context, conn *cloudwatchlogs.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findIntegrationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.IntegrationStatus), nil
+	}
+}
+
+func waitIntegrationActive(ctx context.Context, conn *cloudwatchlogs.Client, name string, timeout time.Duration) (*cloudwatchlogs.GetIntegrationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IntegrationStatusProvisioning),
+		Target:  enum.Slice(awstypes.IntegrationStatusActive),
+		Refresh: statusIntegration(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetIntegrationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *cloudwatchlogs.Client, name string, timeout time.Duration) (*cloudwatchlogs.GetIntegrationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IntegrationStatusActive, awstypes.IntegrationStatusFailed, awstypes.IntegrationStatusProvisioning),
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetIntegrationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type integrationResourceModel struct {
+	IntegrationName   types.String                                         `tfsdk:"integration_name"`
+	IntegrationStatus fwtypes.StringEnum[awstypes.IntegrationStatus]       `tfsdk:"integration_status"`
+	IntegrationType   fwtypes.StringEnum[awstypes.IntegrationType]         `tfsdk:"integration_type"`
+	ResourceConfig    fwtypes.ListNestedObjectValueOf[resourceConfigModel] `tfsdk:"resource_config"`
+	Timeouts          timeouts.Value                                       `tfsdk:"timeouts"`
+}
+
+type resourceConfigModel struct {
+	OpenSearchResourceConfig fwtypes.ListNestedObjectValueOf[openSearchResourceConfigModel] `tfsdk:"opensearch_resource_config"`
+}
+
+func (resourceConfigModel) UnionMembers() []any {
+	return []any{
+		&awstypes.ResourceConfigMemberOpenSearchResourceConfig{},
+	}
+}
+
+type openSearchResourceConfigModel struct {
+	ApplicationARN            fwtypes.ARN          `tfsdk:"application_arn"`
+	DashboardViewerPrincipals fwtypes.ListOfString `tfsdk:"dashboard_viewer_principals"`
+	DataSourceRoleARN         fwtypes.ARN          `tfsdk:"data_source_role_arn"`
+	KMSKeyARN                 fwtypes.ARN          `tfsdk:"kms_key_arn"`
+	RetentionDays             types.Int32          `tfsdk:"retention_days"`
+}
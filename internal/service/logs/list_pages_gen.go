@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeAccountPolicies,DescribeIndexPolicies,DescribeQueryDefinitions,DescribeResourcePolicies"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeAccountPolicies,DescribeDeliveryDestinations,DescribeDeliverySources,DescribeIndexPolicies,DescribeQueryDefinitions,DescribeResourcePolicies,ListLogAnomalyDetectors"; DO NOT EDIT.
 
 package logs
 
@@ -25,6 +25,38 @@ func describeAccountPoliciesPages(ctx context.Context, conn *cloudwatchlogs.Clie
 	}
 	return nil
 }
+func describeDeliveryDestinationsPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeDeliveryDestinationsInput, fn func(*cloudwatchlogs.DescribeDeliveryDestinationsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeDeliveryDestinations(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeDeliverySourcesPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeDeliverySourcesInput, fn func(*cloudwatchlogs.DescribeDeliverySourcesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeDeliverySources(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeIndexPoliciesPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeIndexPoliciesInput, fn func(*cloudwatchlogs.DescribeIndexPoliciesOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeIndexPolicies(ctx, input)
@@ -73,3 +105,19 @@ func describeResourcePoliciesPages(ctx context.Context, conn *cloudwatchlogs.Cli
 	}
 	return nil
 }
+func listLogAnomalyDetectorsPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.ListLogAnomalyDetectorsInput, fn func(*cloudwatchlogs.ListLogAnomalyDetectorsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListLogAnomalyDetectors(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourceListers returns the listers of existing CloudWatch Logs resources.
+func (p *servicePackage) ResourceListers(context.Context) []*types.ServicePackageResourceLister {
+	return []*types.ServicePackageResourceLister{
+		{
+			List:     listAnomalyDetectorImportIDs,
+			TypeName: "aws_cloudwatch_log_anomaly_detector",
+		},
+		{
+			List:     listDeliveryDestinationImportIDs,
+			TypeName: "aws_cloudwatch_log_delivery_destination",
+		},
+		{
+			List:     listDeliverySourceImportIDs,
+			TypeName: "aws_cloudwatch_log_delivery_source",
+		},
+	}
+}
+
+// listAnomalyDetectorImportIDs returns the import IDs (ARNs) of all log anomaly detectors.
+func listAnomalyDetectorImportIDs(ctx context.Context, meta any) ([]string, error) {
+	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
+	var ids []string
+
+	err := listLogAnomalyDetectorsPages(ctx, meta.(*conns.AWSClient).LogsClient(ctx), input, func(page *cloudwatchlogs.ListLogAnomalyDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			ids = append(ids, aws.ToString(v.AnomalyDetectorArn))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+// listDeliveryDestinationImportIDs returns the import IDs (names) of all delivery destinations.
+func listDeliveryDestinationImportIDs(ctx context.Context, meta any) ([]string, error) {
+	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
+	var ids []string
+
+	err := describeDeliveryDestinationsPages(ctx, meta.(*conns.AWSClient).LogsClient(ctx), input, func(page *cloudwatchlogs.DescribeDeliveryDestinationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DeliveryDestinations {
+			ids = append(ids, aws.ToString(v.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+// listDeliverySourceImportIDs returns the import IDs (names) of all delivery sources.
+func listDeliverySourceImportIDs(ctx context.Context, meta any) ([]string, error) {
+	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
+	var ids []string
+
+	err := describeDeliverySourcesPages(ctx, meta.(*conns.AWSClient).LogsClient(ctx), input, func(page *cloudwatchlogs.DescribeDeliverySourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DeliverySources {
+			ids = append(ids, aws.ToString(v.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
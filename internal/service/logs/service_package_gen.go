@@ -27,6 +27,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: types.RegionalARNIdentity(names.AttrARN),
 		},
 		{
 			Factory:  newDeliveryResource,
@@ -43,6 +44,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrName),
 		},
 		{
 			Factory:  newDeliveryDestinationPolicyResource,
@@ -56,12 +58,23 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: types.RegionalSingleParameterIdentity(names.AttrName),
 		},
 		{
 			Factory:  newIndexPolicyResource,
 			TypeName: "aws_cloudwatch_log_index_policy",
 			Name:     "Index Policy",
 		},
+		{
+			Factory:  newIntegrationResource,
+			TypeName: "aws_cloudwatch_log_integration",
+			Name:     "Integration",
+		},
+		{
+			Factory:  newTransformerResource,
+			TypeName: "aws_cloudwatch_log_transformer",
+			Name:     "Transformer",
+		},
 	}
 }
 
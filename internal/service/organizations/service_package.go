@@ -28,3 +28,9 @@ func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]a
 		},
 	}
 }
+
+// ConcurrencyLimit returns the default maximum number of in-flight mutating Organizations API calls.
+// Organizations rejects concurrent changes with ConcurrentModificationException.
+func (p *servicePackage) ConcurrencyLimit(context.Context) int {
+	return 1
+}
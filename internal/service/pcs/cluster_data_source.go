@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrEndpoints: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"networking": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[networkingModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[networkingModel](ctx),
+				Computed:    true,
+			},
+			"scheduler": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[schedulerModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[schedulerModel](ctx),
+				Computed:    true,
+			},
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Computed:   true,
+			},
+			"slurm_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[clusterSlurmConfigurationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[clusterSlurmConfigurationModel](ctx),
+				Computed:    true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	identifier := data.Identifier.ValueString()
+	output, err := findClusterByID(ctx, conn, identifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", identifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                types.String                                                    `tfsdk:"arn"`
+	CreatedAt          timetypes.RFC3339                                               `tfsdk:"created_at"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Identifier         types.String                                                    `tfsdk:"identifier"`
+	ModifiedAt         timetypes.RFC3339                                               `tfsdk:"modified_at"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Status             fwtypes.StringEnum[awstypes.ClusterStatus]                      `tfsdk:"status"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_compute_node_group", name="Compute Node Group")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newComputeNodeGroupDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &computeNodeGroupDataSource{}, nil
+}
+
+type computeNodeGroupDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *computeNodeGroupDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ami_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrClusterIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"custom_launch_template": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customLaunchTemplateModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[customLaunchTemplateModel](ctx),
+				Computed:    true,
+			},
+			"iam_instance_profile_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"instance_config": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[instanceConfigModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[instanceConfigModel](ctx),
+				Computed:    true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"purchase_option": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PurchaseOption](),
+				Computed:   true,
+			},
+			"scaling_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[scalingConfigurationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[scalingConfigurationModel](ctx),
+				Computed:    true,
+			},
+			"slurm_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[computeNodeGroupSlurmConfigurationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[computeNodeGroupSlurmConfigurationModel](ctx),
+				Computed:    true,
+			},
+			"spot_options": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[spotOptionsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[spotOptionsModel](ctx),
+				Computed:    true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComputeNodeGroupStatus](),
+				Computed:   true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *computeNodeGroupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data computeNodeGroupDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	identifier := data.Identifier.ValueString()
+	output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, data.ClusterIdentifier.ValueString(), identifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Compute Node Group (%s)", identifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type computeNodeGroupDataSourceModel struct {
+	AMIID                 types.String                                                             `tfsdk:"ami_id"`
+	ARN                   types.String                                                             `tfsdk:"arn"`
+	ClusterIdentifier     types.String                                                             `tfsdk:"cluster_identifier"`
+	CreatedAt             timetypes.RFC3339                                                        `tfsdk:"created_at"`
+	CustomLaunchTemplate  fwtypes.ListNestedObjectValueOf[customLaunchTemplateModel]               `tfsdk:"custom_launch_template"`
+	IAMInstanceProfileARN types.String                                                             `tfsdk:"iam_instance_profile_arn"`
+	ID                    types.String                                                             `tfsdk:"id"`
+	Identifier            types.String                                                             `tfsdk:"identifier"`
+	InstanceConfigs       fwtypes.ListNestedObjectValueOf[instanceConfigModel]                     `tfsdk:"instance_config"`
+	ModifiedAt            timetypes.RFC3339                                                        `tfsdk:"modified_at"`
+	Name                  types.String                                                             `tfsdk:"name"`
+	PurchaseOption        fwtypes.StringEnum[awstypes.PurchaseOption]                              `tfsdk:"purchase_option"`
+	ScalingConfiguration  fwtypes.ListNestedObjectValueOf[scalingConfigurationModel]               `tfsdk:"scaling_configuration"`
+	SlurmConfiguration    fwtypes.ListNestedObjectValueOf[computeNodeGroupSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	SpotOptions           fwtypes.ListNestedObjectValueOf[spotOptionsModel]                        `tfsdk:"spot_options"`
+	Status                fwtypes.StringEnum[awstypes.ComputeNodeGroupStatus]                      `tfsdk:"status"`
+	SubnetIDs             fwtypes.SetOfString                                                      `tfsdk:"subnet_ids"`
+	Tags                  tftags.Map                                                               `tfsdk:"tags"`
+}
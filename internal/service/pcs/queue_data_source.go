@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newQueueDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queueDataSource{}, nil
+}
+
+type queueDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *queueDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrClusterIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"compute_node_group_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				Computed:    true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueueStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *queueDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queueDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	identifier := data.Identifier.ValueString()
+	output, err := findQueueByTwoPartKey(ctx, conn, data.ClusterIdentifier.ValueString(), identifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", identifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type queueDataSourceModel struct {
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterIdentifier              types.String                                                        `tfsdk:"cluster_identifier"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	CreatedAt                      timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	ID                             types.String                                                        `tfsdk:"id"`
+	Identifier                     types.String                                                        `tfsdk:"identifier"`
+	ModifiedAt                     timetypes.RFC3339                                                   `tfsdk:"modified_at"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	Status                         fwtypes.StringEnum[awstypes.QueueStatus]                            `tfsdk:"status"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+}
@@ -8,17 +8,61 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfresourcegroups "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestResourceImportID(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	groupARN := "arn:aws:resource-groups:us-west-2:123456789012:group/test"                // lintignore:AWSAT003,AWSAT005 // unit test
+	resourceARN := "arn:aws:ec2:us-west-2:123456789012:dedicated-host/h-0123456789abcdef0" // lintignore:AWSAT003,AWSAT005 // unit test
+	v := types.ListGroupResourcesItem{
+		Identifier: &types.ResourceIdentifier{
+			ResourceArn: aws.String(resourceARN),
+		},
+	}
+
+	id, err := tfresourcegroups.ResourceImportID(groupARN, v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The listed ID must be accepted by the resource's importer and Read.
+	r := tfresourcegroups.ResourceResource()
+	d := r.Data(nil)
+	d.SetId(id)
+
+	results, err := r.Importer.StateContext(ctx, d, nil)
+	if err != nil {
+		t.Fatalf("unexpected import error: %s", err)
+	}
+	if got, want := len(results), 1; got != want {
+		t.Fatalf("expected %d imported resources, got %d", want, got)
+	}
+
+	parts, err := flex.ExpandResourceId(results[0].Id(), tfresourcegroups.ResourceIDPartCount, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := parts[0], groupARN; got != want {
+		t.Errorf("expected group ARN %q, got %q", want, got)
+	}
+	if got, want := parts[1], resourceARN; got != want {
+		t.Errorf("expected resource ARN %q, got %q", want, got)
+	}
+}
+
 func TestAccResourceGroupsResource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var r types.ListGroupResourcesItem
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroups
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourceListers returns the listers of existing Resource Groups resources.
+func (p *servicePackage) ResourceListers(context.Context) []*types.ServicePackageResourceLister {
+	return []*types.ServicePackageResourceLister{
+		{
+			List:     listResourceImportIDs,
+			TypeName: "aws_resourcegroups_resource",
+		},
+	}
+}
+
+// listResourceImportIDs returns the import IDs of all resources in all groups.
+func listResourceImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+	var ids []string
+
+	pages := resourcegroups.NewListGroupsPaginator(conn, &resourcegroups.ListGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range page.GroupIdentifiers {
+			input := &resourcegroups.ListGroupResourcesInput{
+				Group: group.GroupArn,
+			}
+
+			pages := resourcegroups.NewListGroupResourcesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Resources {
+					if v.Identifier == nil {
+						continue
+					}
+
+					id, err := resourceImportID(aws.ToString(group.GroupArn), v)
+
+					if err != nil {
+						return nil, err
+					}
+
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// resourceImportID returns the import ID of the specified group's resource.
+func resourceImportID(groupARN string, v awstypes.ListGroupResourcesItem) (string, error) {
+	return flex.FlattenResourceId([]string{groupARN, aws.ToString(v.Identifier.ResourceArn)}, resourceIDPartCount, false)
+}
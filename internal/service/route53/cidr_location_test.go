@@ -13,11 +13,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestCIDRLocationImportID(t *testing.T) {
+	t.Parallel()
+
+	id, err := tfroute53.CIDRLocationImportID("50c328ab-5145-b3ed-77ab-6241355c43fb", "wzv44e9s6lr6p7pj")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The listed ID must be accepted by the resource's importer.
+	parts, err := flex.ExpandResourceId(id, tfroute53.CIDRLocationResourceIDPartCount, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := parts[0], "50c328ab-5145-b3ed-77ab-6241355c43fb"; got != want {
+		t.Errorf("expected CIDR collection ID %q, got %q", want, got)
+	}
+	if got, want := parts[1], "wzv44e9s6lr6p7pj"; got != want {
+		t.Errorf("expected location name %q, got %q", want, got)
+	}
+}
+
 func TestAccRoute53CIDRLocation_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_cidr_location.test"
@@ -19,6 +19,8 @@ var (
 	ResourceZone                        = resourceZone
 	ResourceZoneAssociation             = resourceZoneAssociation
 
+	CIDRLocationImportID                        = cidrLocationImportID
+	CIDRLocationResourceIDPartCount             = cidrLocationResourceIDPartCount
 	CleanZoneID                                 = cleanZoneID
 	ExpandRecordName                            = expandRecordName
 	FindCIDRCollectionByID                      = findCIDRCollectionByID
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ConcurrencyLimit returns the default maximum number of in-flight mutating Route 53 API calls.
+// Route 53 limits ChangeResourceRecordSets to five requests per second per account.
+func (p *servicePackage) ConcurrencyLimit(context.Context) int {
+	return 5
+}
+
+// ResourceListers returns the listers of existing Route 53 resources.
+func (p *servicePackage) ResourceListers(context.Context) []*itypes.ServicePackageResourceLister {
+	return []*itypes.ServicePackageResourceLister{
+		{
+			List:     listCIDRCollectionImportIDs,
+			TypeName: "aws_route53_cidr_collection",
+		},
+		{
+			List:     listCIDRLocationImportIDs,
+			TypeName: "aws_route53_cidr_location",
+		},
+	}
+}
+
+// listCIDRCollectionImportIDs returns the import IDs of all CIDR collections.
+func listCIDRCollectionImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	output, err := findCIDRCollections(ctx, conn, &route53.ListCidrCollectionsInput{}, tfslices.PredicateTrue[*awstypes.CollectionSummary]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.CollectionSummary) string {
+		return aws.ToString(v.Id)
+	}), nil
+}
+
+// listCIDRLocationImportIDs returns the import IDs of all locations in all CIDR collections.
+func listCIDRLocationImportIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	collections, err := findCIDRCollections(ctx, conn, &route53.ListCidrCollectionsInput{}, tfslices.PredicateTrue[*awstypes.CollectionSummary]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, collection := range collections {
+		input := &route53.ListCidrLocationsInput{
+			CollectionId: collection.Id,
+		}
+
+		pages := route53.NewListCidrLocationsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.CidrLocations {
+				id, err := cidrLocationImportID(aws.ToString(collection.Id), aws.ToString(v.LocationName))
+
+				if err != nil {
+					return nil, err
+				}
+
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// cidrLocationImportID returns the import ID of the specified CIDR collection's location.
+func cidrLocationImportID(collectionID, locationName string) (string, error) {
+	data := cidrLocationResourceModel{
+		CIDRCollectionID: types.StringValue(collectionID),
+		Name:             types.StringValue(locationName),
+	}
+
+	return data.setID()
+}
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_metadata_table_configuration", name="Bucket Metadata Table Configuration")
+func newResourceBucketMetadataTableConfiguration(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBucketMetadataTableConfiguration{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceBucketMetadataTableConfiguration struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceBucketMetadataTableConfiguration) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"error_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"error_message": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata_table_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metadataTableConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3tables_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TablesDestinationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"table_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"table_bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"table_namespace": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBucketMetadataTableConfiguration) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceBucketMetadataTableConfigurationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket := data.Bucket.ValueString()
+	var input s3.CreateBucketMetadataTableConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (any, error) {
+		return conn.CreateBucketMetadataTableConfiguration(ctx, &input)
+	}, errCodeNoSuchBucket)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Metadata Table Configuration", bucket), err.Error())
+		return
+	}
+
+	expectedBucketOwner := data.ExpectedBucketOwner.ValueString()
+	output, err := waitBucketMetadataTableConfigurationCreated(ctx, conn, bucket, expectedBucketOwner, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket) // Set 'bucket' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), data.ExpectedBucketOwner)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Metadata Table Configuration create", bucket), err.Error())
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(r.flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceBucketMetadataTableConfiguration) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceBucketMetadataTableConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	output, err := findBucketMetadataTableConfiguration(ctx, conn, data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString())
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Metadata Table Configuration", data.Bucket.ValueString()), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceBucketMetadataTableConfiguration) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceBucketMetadataTableConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket := data.Bucket.ValueString()
+	input := s3.DeleteBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	expectedBucketOwner := data.ExpectedBucketOwner.ValueString()
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketMetadataTableConfiguration(ctx, &input)
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableNotFound) {
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Metadata Table Configuration", bucket), err.Error())
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (any, error) {
+		return findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Metadata Table Configuration", bucket), fmt.Sprintf("While waiting: %s", err.Error()))
+		return
+	}
+}
+
+func (r *resourceBucketMetadataTableConfiguration) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, err := parseResourceID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+}
+
+func (r *resourceBucketMetadataTableConfiguration) flatten(ctx context.Context, result *awstypes.GetBucketMetadataTableConfigurationResult, data *resourceBucketMetadataTableConfigurationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Status = fwflex.StringToFramework(ctx, result.Status)
+	if v := result.Error; v != nil {
+		data.ErrorCode = fwflex.StringToFramework(ctx, v.ErrorCode)
+		data.ErrorMessage = fwflex.StringToFramework(ctx, v.ErrorMessage)
+	} else {
+		data.ErrorCode = types.StringNull()
+		data.ErrorMessage = types.StringNull()
+	}
+
+	if v := result.MetadataTableConfigurationResult; v != nil && v.S3TablesDestinationResult != nil {
+		var destination s3TablesDestinationModel
+		diags.Append(fwflex.Flatten(ctx, v.S3TablesDestinationResult, &destination)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.MetadataTableConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &metadataTableConfigurationModel{
+			S3TablesDestination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &destination),
+		})
+	}
+
+	return diags
+}
+
+func findBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	input := s3.GetBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketMetadataTableConfiguration(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GetBucketMetadataTableConfigurationResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GetBucketMetadataTableConfigurationResult, nil
+}
+
+func statusBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitBucketMetadataTableConfigurationCreated(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, timeout time.Duration) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{metadataTableStatusCreating},
+		Target:  []string{metadataTableStatusActive},
+		Refresh: statusBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GetBucketMetadataTableConfigurationResult); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	metadataTableStatusActive   = "ACTIVE"
+	metadataTableStatusCreating = "CREATING"
+)
+
+type resourceBucketMetadataTableConfigurationModel struct {
+	Bucket                     types.String                                                     `tfsdk:"bucket"`
+	ErrorCode                  types.String                                                     `tfsdk:"error_code"`
+	ErrorMessage               types.String                                                     `tfsdk:"error_message"`
+	ExpectedBucketOwner        types.String                                                     `tfsdk:"expected_bucket_owner"`
+	MetadataTableConfiguration fwtypes.ListNestedObjectValueOf[metadataTableConfigurationModel] `tfsdk:"metadata_table_configuration"`
+	Status                     types.String                                                     `tfsdk:"status"`
+	Timeouts                   timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type metadataTableConfigurationModel struct {
+	S3TablesDestination fwtypes.ListNestedObjectValueOf[s3TablesDestinationModel] `tfsdk:"s3tables_destination"`
+}
+
+type s3TablesDestinationModel struct {
+	TableARN       types.String `tfsdk:"table_arn"`
+	TableBucketARN fwtypes.ARN  `tfsdk:"table_bucket_arn"`
+	TableName      types.String `tfsdk:"table_name"`
+	TableNamespace types.String `tfsdk:"table_namespace"`
+}
@@ -13,6 +13,7 @@ var (
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = newResourceBucketLifecycleConfiguration
 	ResourceBucketLogging                           = resourceBucketLogging
+	ResourceBucketMetadataTableConfiguration        = newResourceBucketMetadataTableConfiguration
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
@@ -38,6 +39,7 @@ var (
 	FindBucketACL                         = findBucketACL
 	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
 	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
+	FindBucketMetadataTableConfiguration  = findBucketMetadataTableConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
 	FindBucketRequestPayment              = findBucketRequestPayment
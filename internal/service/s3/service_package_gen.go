@@ -31,6 +31,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
 		},
+		{
+			Factory:  newResourceBucketMetadataTableConfiguration,
+			TypeName: "aws_s3_bucket_metadata_table_configuration",
+			Name:     "Bucket Metadata Table Configuration",
+		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
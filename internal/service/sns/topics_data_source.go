@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sns_topics", name="Topics")
+func newTopicsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &topicsDataSource{}, nil
+}
+
+type topicsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *topicsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+			},
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *topicsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data topicsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SNSClient(ctx)
+
+	namePrefix := data.NamePrefix.ValueString()
+	input := &sns.ListTopicsInput{}
+	topics, err := findTopicsPages(ctx, conn, input, func(v awstypes.Topic) bool {
+		name := topicNameFromARN(aws.ToString(v.TopicArn))
+
+		if namePrefix != "" && !strings.HasPrefix(name, namePrefix) {
+			return false
+		}
+
+		if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(name) {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("reading SNS Topics", err.Error())
+
+		return
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(topics, func(v awstypes.Topic) string {
+		return aws.ToString(v.TopicArn)
+	}))
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	data.Names = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(topics, func(v awstypes.Topic) string {
+		return topicNameFromARN(aws.ToString(v.TopicArn))
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findTopicsPages(ctx context.Context, conn *sns.Client, input *sns.ListTopicsInput, filter tfslices.Predicate[awstypes.Topic]) ([]awstypes.Topic, error) {
+	var output []awstypes.Topic
+
+	err := listTopicsPages(ctx, conn, input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Topics {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func topicNameFromARN(s string) string {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	return v.Resource
+}
+
+type topicsDataSourceModel struct {
+	ARNs       fwtypes.ListOfString `tfsdk:"arns"`
+	ID         types.String         `tfsdk:"id"`
+	NamePrefix types.String         `tfsdk:"name_prefix"`
+	NameRegex  fwtypes.Regexp       `tfsdk:"name_regex"`
+	Names      fwtypes.ListOfString `tfsdk:"names"`
+}
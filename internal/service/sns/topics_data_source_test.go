@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_sns_topics.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicsDataSourceConfig_namePrefix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "arns.*", "aws_sns_topic.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "arns.*", "aws_sns_topic.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "names.*", "aws_sns_topic.test.0", names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "names.*", "aws_sns_topic.test.1", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicsDataSource_nameRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_sns_topics.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicsDataSourceConfig_nameRegex(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", "aws_sns_topic.test.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", "aws_sns_topic.test.1", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTopicsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+`, rName)
+}
+
+func testAccTopicsDataSourceConfig_namePrefix(rName string) string {
+	return acctest.ConfigCompose(testAccTopicsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_sns_topics" "test" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_sns_topic.test]
+}
+`, rName))
+}
+
+func testAccTopicsDataSourceConfig_nameRegex(rName string) string {
+	return acctest.ConfigCompose(testAccTopicsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_sns_topics" "test" {
+  name_regex = "^%[1]s-1$"
+
+  depends_on = [aws_sns_topic.test]
+}
+`, rName))
+}
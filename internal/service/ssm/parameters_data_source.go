@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssm_parameters", name="Parameters")
+func newParametersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &parametersDataSource{}, nil
+}
+
+type parametersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *parametersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrNames: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrPath: schema.StringAttribute{
+				Optional: true,
+			},
+			"recursive": schema.BoolAttribute{
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(
+						path.MatchRoot(names.AttrPath),
+					),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+				Optional:   true,
+			},
+			"types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *parametersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data parametersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMClient(ctx)
+
+	input := &ssm.DescribeParametersInput{}
+
+	if !data.Path.IsNull() {
+		option := "OneLevel"
+		if data.Recursive.ValueBool() {
+			option = "Recursive"
+		}
+
+		input.ParameterFilters = append(input.ParameterFilters, awstypes.ParameterStringFilter{
+			Key:    aws.String("Path"),
+			Option: aws.String(option),
+			Values: []string{data.Path.ValueString()},
+		})
+	}
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.ParameterFilters = append(input.ParameterFilters, awstypes.ParameterStringFilter{
+			Key:    aws.String("tag:" + k),
+			Option: aws.String("Equals"),
+			Values: []string{v},
+		})
+	}
+
+	if !data.Type.IsNull() {
+		input.ParameterFilters = append(input.ParameterFilters, awstypes.ParameterStringFilter{
+			Key:    aws.String("Type"),
+			Option: aws.String("Equals"),
+			Values: []string{data.Type.ValueString()},
+		})
+	}
+
+	output, err := findParametersMetadataPages(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading SSM Parameters", err.Error())
+
+		return
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) string {
+		return aws.ToString(v.ARN)
+	}))
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	data.Names = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) string {
+		return aws.ToString(v.Name)
+	}))
+	data.Types = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) string {
+		return string(v.Type)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findParametersMetadataPages(ctx context.Context, conn *ssm.Client, input *ssm.DescribeParametersInput) ([]awstypes.ParameterMetadata, error) {
+	var output []awstypes.ParameterMetadata
+
+	err := describeParametersPages(ctx, conn, input, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Parameters...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type parametersDataSourceModel struct {
+	ARNs      fwtypes.ListOfString                       `tfsdk:"arns"`
+	ID        types.String                               `tfsdk:"id"`
+	Names     fwtypes.ListOfString                       `tfsdk:"names"`
+	Path      types.String                               `tfsdk:"path"`
+	Recursive types.Bool                                 `tfsdk:"recursive"`
+	Tags      fwtypes.MapOfString                        `tfsdk:"tags"`
+	Type      fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+	Types     fwtypes.ListOfString                       `tfsdk:"types"`
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParametersDataSource_path(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersDataSourceConfig_path(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "types.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_ssm_parameter.test1", names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "aws_ssm_parameter.test2", names.AttrName),
+				),
+			},
+			{
+				Config: testAccParametersDataSourceConfig_path(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "types.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParametersDataSource_tagsAndType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersDataSourceConfig_tagsAndType(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_ssm_parameter.test2", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", "aws_ssm_parameter.test2", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "types.0", "SecureString"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParametersDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test1" {
+  name  = "/%[1]s/param-a"
+  type  = "String"
+  value = "TestValueA"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ssm_parameter" "test2" {
+  name  = "/%[1]s/param-b"
+  type  = "SecureString"
+  value = "TestValueB"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ssm_parameter" "test3" {
+  name  = "/%[1]s/nested/param-c"
+  type  = "String"
+  value = "TestValueC"
+}
+`, rName)
+}
+
+func testAccParametersDataSourceConfig_path(rName string, recursive bool) string {
+	return acctest.ConfigCompose(testAccParametersDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssm_parameters" "test" {
+  path      = "/%[1]s"
+  recursive = %[2]t
+
+  depends_on = [
+    aws_ssm_parameter.test1,
+    aws_ssm_parameter.test2,
+    aws_ssm_parameter.test3,
+  ]
+}
+`, rName, recursive))
+}
+
+func testAccParametersDataSourceConfig_tagsAndType(rName string) string {
+	return acctest.ConfigCompose(testAccParametersDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssm_parameters" "test" {
+  type = "SecureString"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [
+    aws_ssm_parameter.test1,
+    aws_ssm_parameter.test2,
+    aws_ssm_parameter.test3,
+  ]
+}
+`, rName))
+}
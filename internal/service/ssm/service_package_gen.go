@@ -26,6 +26,11 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newParametersDataSource,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  newDataSourcePatchBaselines,
 			TypeName: "aws_ssm_patch_baselines",
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Assume role arguments are shared by the provider's `assume_role` and `assume_role_with_web_identity`
+// configuration blocks and by this package's ephemeral resources.
+const (
+	AssumeRoleDurationDescription          = "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m."
+	AssumeRoleExternalIDDescription        = "A unique identifier that might be required when you assume a role in another account."
+	AssumeRolePolicyDescription            = "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed."
+	AssumeRolePolicyARNsDescription        = "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed."
+	AssumeRoleRoleARNDescription           = "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls."
+	AssumeRoleSessionNameDescription       = "An identifier for the assumed role session."
+	AssumeRoleSourceIdentityDescription    = "Source identity specified by the principal assuming the role."
+	AssumeRoleTagsDescription              = "Assume role session tags."
+	AssumeRoleTransitiveTagKeysDescription = "Assume role session tag keys to pass to any subsequent sessions."
+)
+
+const (
+	AssumeRoleDurationMin = 15 * time.Minute
+	AssumeRoleDurationMax = 12 * time.Hour
+
+	AssumeRoleExternalIDMinLength     = 2
+	AssumeRoleExternalIDMaxLength     = 1224
+	AssumeRoleSessionNameMinLength    = 2
+	AssumeRoleSessionNameMaxLength    = 64
+	AssumeRoleSourceIdentityMinLength = 2
+	AssumeRoleSourceIdentityMaxLength = 64
+
+	WebIdentityTokenMinLength = 4
+	WebIdentityTokenMaxLength = 20000
+)
+
+var (
+	AssumeRoleExternalIDRegexp     = regexache.MustCompile(`[\w+=,.@:\/\-]*`)
+	AssumeRoleSessionNameRegexp    = regexache.MustCompile(`[\w+=,.@\-]*`)
+	AssumeRoleSourceIdentityRegexp = regexache.MustCompile(`[\w+=,.@\-]*`)
+)
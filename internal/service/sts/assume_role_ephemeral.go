@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newEphemeralAssumeRole(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDuration: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: AssumeRoleDurationDescription,
+				Validators: []validator.String{
+					fwvalidators.DurationBetween(AssumeRoleDurationMin, AssumeRoleDurationMax),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional:    true,
+				Description: AssumeRoleExternalIDDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(AssumeRoleExternalIDMinLength, AssumeRoleExternalIDMaxLength),
+					stringvalidator.RegexMatches(AssumeRoleExternalIDRegexp, ""),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType:  fwtypes.IAMPolicyType,
+				Optional:    true,
+				Description: AssumeRolePolicyDescription,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				Optional:    true,
+				Description: AssumeRolePolicyARNsDescription,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				Description: AssumeRoleRoleARNDescription,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: AssumeRoleSessionNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(AssumeRoleSessionNameMinLength, AssumeRoleSessionNameMaxLength),
+					stringvalidator.RegexMatches(AssumeRoleSessionNameRegexp, ""),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional:    true,
+				Description: AssumeRoleSourceIdentityDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(AssumeRoleSourceIdentityMinLength, AssumeRoleSourceIdentityMaxLength),
+					stringvalidator.RegexMatches(AssumeRoleSourceIdentityRegexp, ""),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Optional:    true,
+				Description: AssumeRoleTagsDescription,
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				Description: AssumeRoleTransitiveTagKeysDescription,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SessionName.IsNull() {
+		data.SessionName = types.StringValue(sdkid.UniqueId())
+	}
+
+	input := sts.AssumeRoleInput{
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:        expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		RoleArn:           fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.SessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		Tags:              expandTags(ctx, data.Tags),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+	if !data.Duration.IsNull() {
+		input.DurationSeconds = aws.Int32(int32(data.Duration.ValueDuration().Seconds()))
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+	data.AccessKeyID, data.SecretAccessKey, data.SessionToken, data.Expiration = flattenCredentials(ctx, output.Credentials)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleData struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	Duration          fwtypes.Duration    `tfsdk:"duration"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SessionName       types.String        `tfsdk:"session_name"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
+
+func expandPolicyDescriptorTypes(ctx context.Context, tfSet fwtypes.SetOfARN) []awstypes.PolicyDescriptorType {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+
+	var apiObjects []awstypes.PolicyDescriptorType
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, tfSet) {
+		apiObjects = append(apiObjects, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTags(ctx context.Context, tfMap fwtypes.MapOfString) []awstypes.Tag {
+	if tfMap.IsNull() || tfMap.IsUnknown() {
+		return nil
+	}
+
+	var apiObjects []awstypes.Tag
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, tfMap) {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenCredentials(ctx context.Context, apiObject *awstypes.Credentials) (types.String, types.String, types.String, timetypes.RFC3339) {
+	if apiObject == nil {
+		return types.StringNull(), types.StringNull(), types.StringNull(), timetypes.NewRFC3339Null()
+	}
+
+	return fwflex.StringToFramework(ctx, apiObject.AccessKeyId),
+		fwflex.StringToFramework(ctx, apiObject.SecretAccessKey),
+		fwflex.StringToFramework(ctx, apiObject.SessionToken),
+		timetypes.NewRFC3339TimePointerValue(apiObject.Expiration)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRoleWithWebIdentity = "Ephemeral Resource Assume Role With Web Identity"
+)
+
+// @EphemeralResource(aws_sts_assume_role_with_web_identity, name="Assume Role With Web Identity")
+func newEphemeralAssumeRoleWithWebIdentity(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRoleWithWebIdentity{}, nil
+}
+
+type ephemeralAssumeRoleWithWebIdentity struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRoleWithWebIdentity) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDuration: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: AssumeRoleDurationDescription,
+				Validators: []validator.String{
+					fwvalidators.DurationBetween(AssumeRoleDurationMin, AssumeRoleDurationMax),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType:  fwtypes.IAMPolicyType,
+				Optional:    true,
+				Description: AssumeRolePolicyDescription,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				Optional:    true,
+				Description: AssumeRolePolicyARNsDescription,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				Description: AssumeRoleRoleARNDescription,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: AssumeRoleSessionNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(AssumeRoleSessionNameMinLength, AssumeRoleSessionNameMaxLength),
+					stringvalidator.RegexMatches(AssumeRoleSessionNameRegexp, ""),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"subject_from_web_identity_token": schema.StringAttribute{
+				Computed: true,
+			},
+			"web_identity_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(WebIdentityTokenMinLength, WebIdentityTokenMaxLength),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("web_identity_token"), path.MatchRoot("web_identity_token_file")),
+				},
+			},
+			"web_identity_token_file": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRoleWithWebIdentity) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleWithWebIdentityData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SessionName.IsNull() {
+		data.SessionName = types.StringValue(sdkid.UniqueId())
+	}
+
+	token := data.WebIdentityToken.ValueString()
+	if v := data.WebIdentityTokenFile.ValueString(); v != "" {
+		b, err := os.ReadFile(v)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("web_identity_token_file"),
+				"Reading web identity token file",
+				fmt.Sprintf("reading web identity token file (%s): %s", v, err),
+			)
+			return
+		}
+		token = string(b)
+	}
+
+	input := sts.AssumeRoleWithWebIdentityInput{
+		Policy:           fwflex.StringFromFramework(ctx, data.Policy),
+		PolicyArns:       expandPolicyDescriptorTypes(ctx, data.PolicyARNs),
+		RoleArn:          fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:  fwflex.StringFromFramework(ctx, data.SessionName),
+		WebIdentityToken: aws.String(token),
+	}
+	if !data.Duration.IsNull() {
+		input.DurationSeconds = aws.Int32(int32(data.Duration.ValueDuration().Seconds()))
+	}
+
+	output, err := conn.AssumeRoleWithWebIdentity(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRoleWithWebIdentity, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+	data.AccessKeyID, data.SecretAccessKey, data.SessionToken, data.Expiration = flattenCredentials(ctx, output.Credentials)
+	data.SubjectFromWebIdentityToken = fwflex.StringToFramework(ctx, output.SubjectFromWebIdentityToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleWithWebIdentityData struct {
+	AccessKeyID                 types.String      `tfsdk:"access_key_id"`
+	AssumedRoleARN              types.String      `tfsdk:"assumed_role_arn"`
+	AssumedRoleID               types.String      `tfsdk:"assumed_role_id"`
+	Duration                    fwtypes.Duration  `tfsdk:"duration"`
+	Expiration                  timetypes.RFC3339 `tfsdk:"expiration"`
+	Policy                      fwtypes.IAMPolicy `tfsdk:"policy"`
+	PolicyARNs                  fwtypes.SetOfARN  `tfsdk:"policy_arns"`
+	RoleARN                     fwtypes.ARN       `tfsdk:"role_arn"`
+	SecretAccessKey             types.String      `tfsdk:"secret_access_key"`
+	SessionName                 types.String      `tfsdk:"session_name"`
+	SessionToken                types.String      `tfsdk:"session_token"`
+	SubjectFromWebIdentityToken types.String      `tfsdk:"subject_from_web_identity_token"`
+	WebIdentityToken            types.String      `tfsdk:"web_identity_token"`
+	WebIdentityTokenFile        types.String      `tfsdk:"web_identity_token_file"`
+}
@@ -14,6 +14,26 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newEphemeralAssumeRole,
+			TypeName: "aws_sts_assume_role",
+			Name:     "Assume Role",
+		},
+		{
+			Factory:  newEphemeralAssumeRoleWithWebIdentity,
+			TypeName: "aws_sts_assume_role_with_web_identity",
+			Name:     "Assume Role With Web Identity",
+		},
+		{
+			Factory:  newEphemeralSessionToken,
+			TypeName: "aws_sts_session_token",
+			Name:     "Session Token",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
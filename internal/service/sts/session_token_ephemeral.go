@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSessionToken = "Ephemeral Resource Session Token"
+)
+
+// @EphemeralResource(aws_sts_session_token, name="Session Token")
+func newEphemeralSessionToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSessionToken{}, nil
+}
+
+type ephemeralSessionToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSessionToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDuration: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "The duration, between 15 minutes and 36 hours, of the session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				Validators: []validator.String{
+					fwvalidators.DurationBetween(15*time.Minute, 36*time.Hour),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "The identification number of the MFA device that is associated with the IAM user making the call.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(9, 256),
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_code": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The value provided by the MFA device.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{6}$`), "must be 6 digits"),
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSessionToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epSessionTokenData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := sts.GetSessionTokenInput{
+		SerialNumber: fwflex.StringFromFramework(ctx, data.SerialNumber),
+		TokenCode:    fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+	if !data.Duration.IsNull() {
+		input.DurationSeconds = aws.Int32(int32(data.Duration.ValueDuration().Seconds()))
+	}
+
+	output, err := conn.GetSessionToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameSessionToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AccessKeyID, data.SecretAccessKey, data.SessionToken, data.Expiration = flattenCredentials(ctx, output.Credentials)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epSessionTokenData struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Duration        fwtypes.Duration  `tfsdk:"duration"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SerialNumber    types.String      `tfsdk:"serial_number"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+	TokenCode       types.String      `tfsdk:"token_code"`
+}
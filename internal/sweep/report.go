@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Status is the outcome of running a sweeper.
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusSkipped   Status = "skipped"
+)
+
+// Result is the outcome of running a single sweeper in a region.
+type Result struct {
+	Name     string
+	Status   Status
+	Attempts int
+	Duration time.Duration
+	Err      error
+}
+
+// RegionReport summarizes the sweepers run in a region.
+type RegionReport struct {
+	Region  string
+	Results []Result
+}
+
+func (r RegionReport) count(status Status) int {
+	var n int
+
+	for _, result := range r.Results {
+		if result.Status == status {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Succeeded returns the number of sweepers that completed successfully.
+func (r RegionReport) Succeeded() int {
+	return r.count(StatusSucceeded)
+}
+
+// Failed returns the number of sweepers that failed.
+func (r RegionReport) Failed() int {
+	return r.count(StatusFailed)
+}
+
+// Skipped returns the number of sweepers that were not run.
+func (r RegionReport) Skipped() int {
+	return r.count(StatusSkipped)
+}
+
+func (r RegionReport) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Sweeper summary for region (%s): %d succeeded, %d skipped, %d failed\n", r.Region, r.Succeeded(), r.Skipped(), r.Failed())
+	for _, result := range r.Results {
+		fmt.Fprintf(&sb, "\t- %s: %s", result.Name, result.Status)
+		if result.Attempts > 1 {
+			fmt.Fprintf(&sb, " after %d attempts", result.Attempts)
+		}
+		if result.Attempts > 0 {
+			fmt.Fprintf(&sb, " (%s)", result.Duration.Round(time.Millisecond))
+		}
+		if result.Err != nil {
+			fmt.Fprintf(&sb, ": %s", result.Err)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// Report summarizes the sweepers run in each region.
+type Report []RegionReport
+
+// Failed returns the number of sweepers that failed across all regions.
+func (r Report) Failed() int {
+	var n int
+
+	for _, v := range r {
+		n += v.Failed()
+	}
+
+	return n
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// sweepers contains all registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// The sweeper is registered with both the terraform-plugin-testing sweeper runner and with Run.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+	sweepers[name] = s
+}
+
+// RunOptions contains options for Run.
+type RunOptions struct {
+	// AllowFailures continues sweeping after a sweeper has failed.
+	AllowFailures bool
+	// MaxRetries is the maximum number of times that a failed sweeper is retried.
+	MaxRetries int
+	// Parallelism is the maximum number of sweepers run concurrently in a region.
+	Parallelism int
+}
+
+// Run runs the registered sweepers whose names contain any of the comma-separated filter values,
+// together with their dependencies, in each of the specified regions.
+//
+// A sweeper's Dependencies are the sweepers for resources that must be deleted before the sweeper's own resources.
+// Sweepers are run in dependency order, with independent sweepers run concurrently.
+// When a sweeper fails its dependencies are re-run, cleaning up any resources left behind by other sweepers,
+// and the sweeper is then retried.
+func Run(regions []string, filter string, opts RunOptions) (Report, error) {
+	g, err := sweeperGraph(filterSweepers(filter, sweepers))
+	if err != nil {
+		return nil, err
+	}
+
+	var report Report
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+		start := time.Now()
+		results := runRegion(region, sweepers, g, opts)
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		regionReport := RegionReport{
+			Region:  region,
+			Results: results,
+		}
+		log.Print(regionReport.String())
+
+		report = append(report, regionReport)
+
+		if !opts.AllowFailures && regionReport.Failed() > 0 {
+			break
+		}
+	}
+
+	if report.Failed() > 0 {
+		return report, errors.New("at least one sweeper failed")
+	}
+
+	return report, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filter values,
+// together with all their dependencies.
+// An empty filter returns all sweepers.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+	var add func(string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+		s, ok := source[name]
+		if !ok {
+			return
+		}
+		result[name] = s
+		for _, dep := range s.Dependencies {
+			add(dep)
+		}
+	}
+
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		for name := range source {
+			if strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	// Add nodes in a stable order so that the overall order is deterministic.
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dep := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dep); err != nil {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("sweeper dependencies: %w", err)
+	}
+
+	return g, nil
+}
+
+type nodeState int
+
+const (
+	nodeStatePending nodeState = iota
+	nodeStateRunning
+	nodeStateAwaitingRetry
+	nodeStateSucceeded
+	nodeStateFailed
+	nodeStateSkipped
+)
+
+type node struct {
+	name     string
+	state    nodeState
+	attempts int
+	failures int
+	duration time.Duration
+	err      error
+	// waitingOn contains the dependencies being re-run before this node is retried.
+	waitingOn []string
+}
+
+// settled returns whether the node has finished running and will not be retried.
+func (n *node) settled() bool {
+	return n.state == nodeStateSucceeded || n.state == nodeStateFailed || n.state == nodeStateSkipped
+}
+
+type nodeResult struct {
+	name     string
+	duration time.Duration
+	err      error
+}
+
+// runRegion runs the sweepers in the specified dependency graph in a single region.
+func runRegion(region string, sweepers map[string]*resource.Sweeper, g *depgraph.Graph, opts RunOptions) []Result {
+	order, _ := g.OverallOrder() // Cycles have already been checked for.
+	nodes := make(map[string]*node, len(order))
+	for _, name := range order {
+		nodes[name] = &node{name: name}
+	}
+
+	parallelism := max(opts.Parallelism, 1)
+	results := make(chan nodeResult)
+	var running int
+	var aborted bool
+
+	for {
+		// Start any pending nodes whose dependencies have all settled.
+		for _, name := range order {
+			if aborted || running >= parallelism {
+				break
+			}
+
+			n := nodes[name]
+			if n.state != nodeStatePending {
+				continue
+			}
+
+			deps, _ := g.DirectDependenciesOf(name)
+			if !tfslices.All(deps, func(dep string) bool { return nodes[dep].settled() }) {
+				continue
+			}
+
+			n.state = nodeStateRunning
+			n.attempts++
+			running++
+
+			s := sweepers[name]
+			go func() {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+				start := time.Now()
+				err := s.F(region)
+				results <- nodeResult{name: name, duration: time.Since(start), err: err}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-results
+		running--
+
+		n := nodes[result.name]
+		n.duration += result.duration
+		n.err = result.err
+
+		if result.err != nil {
+			n.failures++
+		}
+
+		switch {
+		case result.err == nil:
+			n.state = nodeStateSucceeded
+		case n.failures <= opts.MaxRetries:
+			log.Printf("[WARN] Sweeper (%s) in region (%s) failed, retrying: %s", n.name, region, result.err)
+			n.state = nodeStateAwaitingRetry
+
+			// Re-run the dependencies that have already succeeded to clean up any resources created since they last ran.
+			deps, _ := g.DirectDependenciesOf(n.name)
+			for _, dep := range deps {
+				switch d := nodes[dep]; d.state {
+				case nodeStateSucceeded:
+					d.state = nodeStatePending
+					fallthrough
+				case nodeStatePending, nodeStateRunning, nodeStateAwaitingRetry:
+					n.waitingOn = append(n.waitingOn, dep)
+				}
+			}
+			if len(n.waitingOn) == 0 {
+				n.state = nodeStatePending
+			}
+		default:
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", n.name, region, result.err)
+			n.state = nodeStateFailed
+			if !opts.AllowFailures {
+				aborted = true
+			}
+		}
+
+		// Retry any nodes that were waiting on this one.
+		if n.settled() {
+			for _, name := range order {
+				if m := nodes[name]; m.state == nodeStateAwaitingRetry && slices.Contains(m.waitingOn, n.name) {
+					m.waitingOn = tfslices.RemoveAll(m.waitingOn, n.name)
+					if len(m.waitingOn) == 0 {
+						m.state = nodeStatePending
+					}
+				}
+			}
+		}
+	}
+
+	report := make([]Result, 0, len(order))
+	for _, name := range order {
+		n := nodes[name]
+		result := Result{
+			Name:     n.name,
+			Attempts: n.attempts,
+			Duration: n.duration,
+		}
+
+		switch n.state {
+		case nodeStateSucceeded:
+			result.Status = StatusSucceeded
+		case nodeStateFailed:
+			result.Status = StatusFailed
+			result.Err = n.err
+		default:
+			result.Status = StatusSkipped
+			result.Err = n.err
+		}
+
+		report = append(report, result)
+	}
+
+	return report
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRunRegion(t *testing.T) {
+	t.Parallel()
+
+	errSweep := errors.New("sweep error")
+
+	testCases := []struct {
+		Name string
+		// Sweepers returns the sweepers to run and appends each call to the specified log.
+		Sweepers        func(calls *callLog) map[string]*resource.Sweeper
+		Options         RunOptions
+		ExpectedCalls   []string
+		ExpectedResults []Result
+	}{
+		{
+			Name: "dependency order",
+			Sweepers: func(calls *callLog) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_vpc":               calls.sweeper("aws_vpc", nil, "aws_subnet", "aws_security_group"),
+					"aws_subnet":            calls.sweeper("aws_subnet", nil, "aws_network_interface"),
+					"aws_security_group":    calls.sweeper("aws_security_group", nil, "aws_network_interface"),
+					"aws_network_interface": calls.sweeper("aws_network_interface", nil),
+				}
+			},
+			Options: RunOptions{Parallelism: 1},
+			ExpectedCalls: []string{
+				"aws_network_interface",
+				"aws_subnet",
+				"aws_security_group",
+				"aws_vpc",
+			},
+			ExpectedResults: []Result{
+				{Name: "aws_network_interface", Status: StatusSucceeded, Attempts: 1},
+				{Name: "aws_security_group", Status: StatusSucceeded, Attempts: 1},
+				{Name: "aws_subnet", Status: StatusSucceeded, Attempts: 1},
+				{Name: "aws_vpc", Status: StatusSucceeded, Attempts: 1},
+			},
+		},
+		{
+			Name: "retry after dependencies re-run",
+			Sweepers: func(calls *callLog) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_security_group":    calls.sweeper("aws_security_group", []error{errSweep}, "aws_network_interface"),
+					"aws_network_interface": calls.sweeper("aws_network_interface", nil),
+				}
+			},
+			Options: RunOptions{MaxRetries: 1, Parallelism: 1},
+			ExpectedCalls: []string{
+				"aws_network_interface",
+				"aws_security_group",
+				"aws_network_interface",
+				"aws_security_group",
+			},
+			ExpectedResults: []Result{
+				{Name: "aws_network_interface", Status: StatusSucceeded, Attempts: 2},
+				{Name: "aws_security_group", Status: StatusSucceeded, Attempts: 2},
+			},
+		},
+		{
+			Name: "retries exhausted",
+			Sweepers: func(calls *callLog) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_iam_role": calls.sweeper("aws_iam_role", []error{errSweep, errSweep}),
+				}
+			},
+			Options: RunOptions{MaxRetries: 1, Parallelism: 1},
+			ExpectedCalls: []string{
+				"aws_iam_role",
+				"aws_iam_role",
+			},
+			ExpectedResults: []Result{
+				{Name: "aws_iam_role", Status: StatusFailed, Attempts: 2, Err: errSweep},
+			},
+		},
+		{
+			Name: "abort on failure",
+			Sweepers: func(calls *callLog) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_vpc":    calls.sweeper("aws_vpc", nil, "aws_subnet"),
+					"aws_subnet": calls.sweeper("aws_subnet", []error{errSweep}),
+				}
+			},
+			Options: RunOptions{Parallelism: 1},
+			ExpectedCalls: []string{
+				"aws_subnet",
+			},
+			ExpectedResults: []Result{
+				{Name: "aws_subnet", Status: StatusFailed, Attempts: 1, Err: errSweep},
+				{Name: "aws_vpc", Status: StatusSkipped},
+			},
+		},
+		{
+			Name: "allow failures",
+			Sweepers: func(calls *callLog) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_vpc":    calls.sweeper("aws_vpc", nil, "aws_subnet"),
+					"aws_subnet": calls.sweeper("aws_subnet", []error{errSweep}),
+				}
+			},
+			Options: RunOptions{AllowFailures: true, Parallelism: 1},
+			ExpectedCalls: []string{
+				"aws_subnet",
+				"aws_vpc",
+			},
+			ExpectedResults: []Result{
+				{Name: "aws_subnet", Status: StatusFailed, Attempts: 1, Err: errSweep},
+				{Name: "aws_vpc", Status: StatusSucceeded, Attempts: 1},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls callLog
+			sweepers := testCase.Sweepers(&calls)
+			g, err := sweeperGraph(sweepers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := runRegion("us-west-2", sweepers, g, testCase.Options) //lintignore:AWSAT003
+
+			if diff := cmp.Diff(calls.calls, testCase.ExpectedCalls); diff != "" {
+				t.Errorf("unexpected calls diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got, testCase.ExpectedResults, cmpopts.SortSlices(func(a, b Result) bool { return a.Name < b.Name }), cmpopts.IgnoreFields(Result{}, "Duration"), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("unexpected results diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunRegionParallelism(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n           = 10
+		parallelism = 3
+	)
+
+	var current, peak atomic.Int32
+	sweepers := make(map[string]*resource.Sweeper)
+	for i := range n {
+		name := "aws_test_" + string(rune('a'+i))
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(string) error {
+				v := current.Add(1)
+				for {
+					p := peak.Load()
+					if v <= p || peak.CompareAndSwap(p, v) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				current.Add(-1)
+				return nil
+			},
+		}
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runRegion("us-west-2", sweepers, g, RunOptions{Parallelism: parallelism}) //lintignore:AWSAT003
+
+	if got, want := (RegionReport{Results: results}).Succeeded(), n; got != want {
+		t.Errorf("got %d succeeded, expected %d", got, want)
+	}
+	if got := peak.Load(); got > parallelism {
+		t.Errorf("got %d concurrent sweepers, expected at most %d", got, parallelism)
+	}
+	if got := peak.Load(); got < 2 {
+		t.Errorf("got %d concurrent sweepers, expected independent sweepers to run concurrently", got)
+	}
+}
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*resource.Sweeper
+		ExpectedError string
+	}{
+		{
+			Name: "missing dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc": {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+			},
+			ExpectedError: "sweeper (aws_vpc) has dependency (aws_subnet), but that sweeper was not found",
+		},
+		{
+			Name: "cycle",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc":    {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				"aws_subnet": {Name: "aws_subnet", Dependencies: []string{"aws_vpc"}},
+			},
+			ExpectedError: "sweeper dependencies: dependency cycle: aws_subnet -> aws_vpc -> aws_subnet",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeperGraph(testCase.Sweepers)
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), testCase.ExpectedError; got != want {
+				t.Errorf("got error %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+// callLog records the order in which sweepers are called.
+type callLog struct {
+	lock  sync.Mutex
+	calls []string
+}
+
+// sweeper returns a sweeper that records each call and returns the specified errors in turn.
+func (l *callLog) sweeper(name string, errs []error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name: name,
+		F: func(string) error {
+			l.lock.Lock()
+			defer l.lock.Unlock()
+
+			l.calls = append(l.calls, name)
+
+			if len(errs) > 0 {
+				err := errs[0]
+				errs = errs[1:]
+				return err
+			}
+
+			return nil
+		},
+		Dependencies: dependencies,
+	}
+}
@@ -5,12 +5,21 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently in each Region")
+	flagSweepRetries     = flag.Int("sweep-retries", 2, "Maximum number of times to retry a failed Sweeper")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +27,22 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by terraform-plugin-testing.
+	flag.Parse()
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		opts := sweep.RunOptions{
+			AllowFailures: flag.Lookup("sweep-allow-failures").Value.(flag.Getter).Get().(bool),
+			MaxRetries:    *flagSweepRetries,
+			Parallelism:   *flagSweepParallelism,
+		}
+
+		if _, err := sweep.Run(strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String(), opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
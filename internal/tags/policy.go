@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// PolicyEnforcement determines how tag policy violations are reported.
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementError   PolicyEnforcement = "error"
+	PolicyEnforcementWarning PolicyEnforcement = "warning"
+)
+
+func (PolicyEnforcement) Values() []PolicyEnforcement {
+	return []PolicyEnforcement{
+		PolicyEnforcementError,
+		PolicyEnforcementWarning,
+	}
+}
+
+// PolicyCase is a letter case rule for tag keys or values.
+type PolicyCase string
+
+const (
+	PolicyCaseCamel  PolicyCase = "camel"
+	PolicyCaseLower  PolicyCase = "lower"
+	PolicyCasePascal PolicyCase = "pascal"
+	PolicyCaseUpper  PolicyCase = "upper"
+)
+
+func (PolicyCase) Values() []PolicyCase {
+	return []PolicyCase{
+		PolicyCaseCamel,
+		PolicyCaseLower,
+		PolicyCasePascal,
+		PolicyCaseUpper,
+	}
+}
+
+var (
+	camelCaseRegexp  = regexache.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+	pascalCaseRegexp = regexache.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+// matches returns whether the specified string satisfies the case rule.
+func (c PolicyCase) matches(s string) bool {
+	switch c {
+	case PolicyCaseCamel:
+		return camelCaseRegexp.MatchString(s)
+	case PolicyCaseLower:
+		return s == strings.ToLower(s)
+	case PolicyCasePascal:
+		return pascalCaseRegexp.MatchString(s)
+	case PolicyCaseUpper:
+		return s == strings.ToUpper(s)
+	default:
+		return true
+	}
+}
+
+// PolicyConfig contains organization rules that resource tags must satisfy.
+type PolicyConfig struct {
+	Enforcement PolicyEnforcement
+	// ExemptResourceTypes are resource type names, or path.Match patterns such as "aws_iam_*",
+	// to which the policy does not apply.
+	ExemptResourceTypes []string
+	// KeyCase, if set, is the case rule that all tag keys must satisfy.
+	KeyCase PolicyCase
+	Rules   []PolicyRule
+}
+
+// PolicyRule contains the rules for a single tag key.
+type PolicyRule struct {
+	Key      string
+	Required bool
+	// AllowedValues, if set, are the only values the tag can have.
+	AllowedValues []string
+	// AllowedPattern, if set, is a regular expression that the tag's value must match.
+	AllowedPattern *regexp.Regexp
+	// ValueCase, if set, is the case rule that the tag's value must satisfy.
+	ValueCase PolicyCase
+	// ExemptResourceTypes are resource type names, or path.Match patterns, to which the rule does not apply.
+	ExemptResourceTypes []string
+}
+
+// IsWarning returns whether violations of the policy are reported as warnings rather than errors.
+func (pc *PolicyConfig) IsWarning() bool {
+	if pc == nil {
+		return false
+	}
+
+	return pc.Enforcement == PolicyEnforcementWarning
+}
+
+// Violations returns a description of each way in which the specified resource type's tags violate the policy.
+// The tags should include any provider default tags.
+func (pc *PolicyConfig) Violations(typeName string, tags KeyValueTags) []string {
+	if pc == nil || isExemptResourceType(typeName, pc.ExemptResourceTypes) {
+		return nil
+	}
+
+	var violations []string
+
+	if pc.KeyCase != "" {
+		keys := tags.Keys()
+		slices.Sort(keys)
+		for _, k := range keys {
+			if !pc.KeyCase.matches(k) {
+				violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, pc.KeyCase))
+			}
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		if isExemptResourceType(typeName, rule.ExemptResourceTypes) {
+			continue
+		}
+
+		v, ok := tags[rule.Key]
+		if !ok {
+			if rule.Required {
+				violation := fmt.Sprintf("tag %q is required", rule.Key)
+				if k, ok := findKeyFold(tags, rule.Key); ok {
+					violation += fmt.Sprintf(" (found %q; tag keys are case-sensitive)", k)
+				}
+				violations = append(violations, violation)
+			}
+			continue
+		}
+
+		value := v.ValueString()
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of %q", rule.Key, value, rule.AllowedValues))
+		}
+
+		if rule.AllowedPattern != nil && !rule.AllowedPattern.MatchString(value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", rule.Key, value, rule.AllowedPattern.String()))
+		}
+
+		if rule.ValueCase != "" && !rule.ValueCase.matches(value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not %s case", rule.Key, value, rule.ValueCase))
+		}
+	}
+
+	return violations
+}
+
+func isExemptResourceType(typeName string, patterns []string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, err := path.Match(pattern, typeName)
+		return err == nil && ok
+	})
+}
+
+func findKeyFold(tags KeyValueTags, key string) (string, bool) {
+	for _, k := range tags.Keys() {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		typeName     string
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "compliant",
+			policyConfig: &PolicyConfig{
+				KeyCase: PolicyCasePascal,
+				Rules: []PolicyRule{
+					{
+						Key:      "CostCenter",
+						Required: true,
+					},
+					{
+						Key:           "Environment",
+						AllowedValues: []string{"dev", "prod"},
+						ValueCase:     PolicyCaseLower,
+					},
+				},
+			},
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+			}),
+		},
+		{
+			name: "required missing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{
+						Key:      "CostCenter",
+						Required: true,
+					},
+					{
+						Key:      "Owner",
+						Required: true,
+					},
+				},
+			},
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"costcenter": "1234",
+			}),
+			want: []string{
+				`tag "CostCenter" is required (found "costcenter"; tag keys are case-sensitive)`,
+				`tag "Owner" is required`,
+			},
+		},
+		{
+			name: "optional missing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{
+						Key:           "Environment",
+						AllowedValues: []string{"dev", "prod"},
+					},
+				},
+			},
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{}),
+		},
+		{
+			name: "values",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{
+						Key:           "Environment",
+						AllowedValues: []string{"dev", "prod"},
+					},
+					{
+						Key:            "CostCenter",
+						AllowedPattern: regexache.MustCompile(`^[0-9]{4}$`),
+					},
+					{
+						Key:       "Team",
+						ValueCase: PolicyCaseUpper,
+					},
+				},
+			},
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "abc",
+				"Environment": "test",
+				"Team":        "Platform",
+			}),
+			want: []string{
+				`tag "Environment" value "test" is not one of ["dev" "prod"]`,
+				`tag "CostCenter" value "abc" does not match "^[0-9]{4}$"`,
+				`tag "Team" value "Platform" is not upper case`,
+			},
+		},
+		{
+			name: "key case",
+			policyConfig: &PolicyConfig{
+				KeyCase: PolicyCaseCamel,
+			},
+			typeName: "aws_test",
+			tags: New(ctx, map[string]string{
+				"costCenter":  "1234",
+				"Environment": "prod",
+				"team_name":   "platform",
+			}),
+			want: []string{
+				`tag key "Environment" is not camel case`,
+				`tag key "team_name" is not camel case`,
+			},
+		},
+		{
+			name: "policy exemption",
+			policyConfig: &PolicyConfig{
+				ExemptResourceTypes: []string{"aws_iam_*"},
+				Rules: []PolicyRule{
+					{
+						Key:      "CostCenter",
+						Required: true,
+					},
+				},
+			},
+			typeName: "aws_iam_role",
+			tags:     New(ctx, map[string]string{}),
+		},
+		{
+			name: "rule exemption",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{
+						Key:                 "CostCenter",
+						Required:            true,
+						ExemptResourceTypes: []string{"aws_test"},
+					},
+					{
+						Key:      "Owner",
+						Required: true,
+					},
+				},
+			},
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{}),
+			want: []string{
+				`tag "Owner" is required`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.typeName, testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -18,12 +19,25 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+// A nil value represents a Regional resource whose Region can be overridden.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Can the resource's Region be overridden by a `region` argument?
+}
+
+// IsRegionOverrideEnabled returns whether the resource's Region can be overridden by a `region` argument.
+func (r *ServicePackageResourceRegion) IsRegionOverrideEnabled() bool {
+	return r == nil || (!r.IsGlobal && r.IsOverrideEnabled)
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -33,6 +47,7 @@ type ServicePackageFrameworkDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -42,6 +57,8 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity Identity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -51,6 +68,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -60,4 +78,99 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity Identity
+}
+
+// ServicePackageResourceLister represents a lister of the import IDs of existing instances of a resource
+// implemented by a service package.
+// meta is the configured *conns.AWSClient.
+type ServicePackageResourceLister struct {
+	List     func(ctx context.Context, meta any) ([]string, error)
+	TypeName string
+}
+
+// Identity represents the set of attributes that uniquely identify an instance of a resource.
+// The zero value represents a resource that does not declare an identity.
+type Identity struct {
+	Attributes        []IdentityAttribute
+	IdentityAttribute string // The attribute set from a plain import ID.
+	IsARN             bool   // The import ID is an ARN.
+	IsGlobalResource  bool   // The resource is not scoped to a Region.
+}
+
+// IdentityAttribute represents a single resource identity attribute.
+type IdentityAttribute struct {
+	Name     string
+	Required bool
+}
+
+// HasIdentity returns whether the resource declares an identity.
+func (i Identity) HasIdentity() bool {
+	return i.IdentityAttribute != ""
+}
+
+// RegionalARNIdentity returns the identity of a Regional resource identified by its ARN.
+// The ARN is held in the specified attribute.
+func RegionalARNIdentity(attribute string) Identity {
+	return Identity{
+		Attributes: []IdentityAttribute{
+			{
+				Name:     attribute,
+				Required: true,
+			},
+		},
+		IdentityAttribute: attribute,
+		IsARN:             true,
+	}
+}
+
+// GlobalARNIdentity returns the identity of a global resource identified by its ARN.
+// The ARN is held in the specified attribute.
+func GlobalARNIdentity(attribute string) Identity {
+	identity := RegionalARNIdentity(attribute)
+	identity.IsGlobalResource = true
+
+	return identity
+}
+
+// RegionalSingleParameterIdentity returns the identity of a Regional resource identified
+// by a single attribute value, qualified by AWS account ID and Region.
+func RegionalSingleParameterIdentity(attribute string) Identity {
+	return Identity{
+		Attributes: []IdentityAttribute{
+			{
+				Name:     names.AttrAccountID,
+				Required: false,
+			},
+			{
+				Name:     names.AttrRegion,
+				Required: false,
+			},
+			{
+				Name:     attribute,
+				Required: true,
+			},
+		},
+		IdentityAttribute: attribute,
+	}
+}
+
+// GlobalSingleParameterIdentity returns the identity of a global resource identified
+// by a single attribute value, qualified by AWS account ID.
+func GlobalSingleParameterIdentity(attribute string) Identity {
+	return Identity{
+		Attributes: []IdentityAttribute{
+			{
+				Name:     names.AttrAccountID,
+				Required: false,
+			},
+			{
+				Name:     attribute,
+				Required: true,
+			},
+		},
+		IdentityAttribute: attribute,
+		IsGlobalResource:  true,
+	}
 }
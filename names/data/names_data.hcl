@@ -45,6 +45,8 @@ service "account" {
   provider_package_correct = "account"
   doc_prefix               = ["account_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "acm" {
@@ -885,6 +887,8 @@ service "billing" {
   provider_package_correct = "billing"
   doc_prefix               = ["billing_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "billingconductor" {
@@ -959,6 +963,8 @@ service "ce" {
   provider_package_correct = "ce"
   doc_prefix               = ["ce_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "chatbot" {
@@ -1303,6 +1309,8 @@ service "cloudfront" {
   provider_package_correct = "cloudfront"
   doc_prefix               = ["cloudfront_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "cloudfrontkeyvaluestore" {
@@ -2360,6 +2368,8 @@ service "cur" {
   provider_package_correct = "cur"
   doc_prefix               = ["cur_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "dataexchange" {
@@ -3733,6 +3743,8 @@ service "globalaccelerator" {
   provider_package_correct = "globalaccelerator"
   doc_prefix               = ["globalaccelerator_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "glue" {
@@ -3916,6 +3928,8 @@ service "iam" {
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "inspector" {
@@ -5623,6 +5637,8 @@ service "networkmanager" {
   provider_package_correct = "networkmanager"
   doc_prefix               = ["networkmanager_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "nimble" {
@@ -5820,6 +5836,8 @@ service "organizations" {
   provider_package_correct = "organizations"
   doc_prefix               = ["organizations_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "outposts" {
@@ -6711,6 +6729,8 @@ service "route53" {
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "route53domains" {
@@ -6737,6 +6757,8 @@ service "route53domains" {
   provider_package_correct = "route53domains"
   doc_prefix               = ["route53domains_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "route53profiles" {
@@ -7500,6 +7522,8 @@ service "shield" {
   provider_package_correct = "shield"
   doc_prefix               = ["shield_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "signer" {
@@ -8269,6 +8293,8 @@ service "waf" {
   provider_package_correct = "waf"
   doc_prefix               = ["waf_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "wafregional" {
@@ -8321,6 +8347,8 @@ service "budgets" {
   provider_package_correct = "budgets"
   doc_prefix               = ["budgets_"]
   brand                    = "AWS"
+
+  is_global = true
 }
 
 service "wellarchitected" {
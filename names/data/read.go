@@ -177,6 +177,10 @@ func (sr ServiceRecord) NotImplemented() bool {
 	return sr.service.NotImplemented
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal
+}
+
 func (sr ServiceRecord) EndpointOnly() bool {
 	if sr.service.ServiceEndpoints != nil {
 		return sr.service.ServiceEndpoints.EndpointOnly
@@ -343,6 +347,7 @@ type Service struct {
 	Exclude                       bool     `hcl:"exclude,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	Note                          string   `hcl:"note,optional"`
 }
 
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// importBlock represents a Terraform import block.
+type importBlock struct {
+	TypeName string
+	ID       string
+}
+
+// renderImportBlocks returns the HCL for the specified import blocks.
+// Each block's resource name is derived from its import ID and is unique within the block's resource type.
+func renderImportBlocks(blocks []importBlock) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	used := make(map[string]bool)
+
+	for i, v := range blocks {
+		name := resourceName(v.ID)
+		for n := 2; used[v.TypeName+"."+name]; n++ {
+			name = fmt.Sprintf("%s_%d", resourceName(v.ID), n)
+		}
+		used[v.TypeName+"."+name] = true
+
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: v.TypeName},
+			hcl.TraverseAttr{Name: name},
+		})
+		block.SetAttributeValue(names.AttrID, cty.StringVal(v.ID))
+	}
+
+	return f.Bytes()
+}
+
+// resourceName returns a valid Terraform resource name derived from the specified import ID.
+// Runs of characters that are not lowercase letters, digits or underscores are replaced with a single underscore.
+func resourceName(id string) string {
+	var sb strings.Builder
+	var underscore bool
+
+	for _, r := range strings.ToLower(id) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			sb.WriteRune(r)
+			underscore = false
+		} else if !underscore {
+			sb.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	name := strings.Trim(sb.String(), "_")
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	return name
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		expected string
+	}{
+		"simple": {
+			id:       "my_bus",
+			expected: "my_bus",
+		},
+		"separators": {
+			id:       "my-bus/My.Rule",
+			expected: "my_bus_my_rule",
+		},
+		"leading digit": {
+			id:       "0123456789,us-west-2",
+			expected: "r_0123456789_us_west_2",
+		},
+		"no valid characters": {
+			id:       "///",
+			expected: "r_",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := resourceName(testCase.id), testCase.expected; got != want {
+				t.Errorf("resourceName(%q) = %q, want %q", testCase.id, got, want)
+			}
+		})
+	}
+}
+
+func TestRenderImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	blocks := []importBlock{
+		{TypeName: "aws_cloudwatch_event_rule", ID: "my-rule"},
+		{TypeName: "aws_cloudwatch_event_rule", ID: "my_rule"},
+		{TypeName: "aws_cloudwatch_event_target", ID: "bus/${rule}/target"},
+	}
+
+	want := `import {
+  to = aws_cloudwatch_event_rule.my_rule
+  id = "my-rule"
+}
+
+import {
+  to = aws_cloudwatch_event_rule.my_rule_2
+  id = "my_rule"
+}
+
+import {
+  to = aws_cloudwatch_event_target.bus_rule_target
+  id = "bus/$${rule}/target"
+}
+`
+
+	if got := string(renderImportBlocks(blocks)); got != want {
+		t.Errorf("renderImportBlocks() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	list       = flag.Bool("list", false, "List the resource types that can be enumerated")
+	outputFile = flag.String("out", "", "Output file (default stdout)")
+	profile    = flag.String("profile", "", "AWS profile")
+	region     = flag.String("region", "", "AWS Region")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportblocks [-region <region>] [-profile <profile>] [-out <file>] <resource-type-pattern>...\n")
+	fmt.Fprintf(os.Stderr, "\timportblocks -list\n\n")
+	fmt.Fprintf(os.Stderr, "Resource type patterns use path.Match syntax, e.g. aws_cloudwatch_event_*.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if !*list && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "importblocks: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, patterns []string) error {
+	p, err := provider.New(ctx)
+	if err != nil {
+		return err
+	}
+
+	listers, err := resourceListers(ctx, p, provider.ServicePackages(ctx))
+	if err != nil {
+		return err
+	}
+
+	if *list {
+		for _, typeName := range slices.Sorted(maps.Keys(listers)) {
+			fmt.Println(typeName)
+		}
+
+		return nil
+	}
+
+	config := map[string]any{}
+	if *profile != "" {
+		config["profile"] = *profile
+	}
+	if *region != "" {
+		config["region"] = *region
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return fmt.Errorf("configuring provider: %v", diags)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	typeNames, err := selectTypeNames(slices.Sorted(maps.Keys(listers)), patterns)
+	if err != nil {
+		return err
+	}
+
+	var blocks []importBlock
+	var failed bool
+
+	for _, typeName := range typeNames {
+		ids, err := listers[typeName].List(ctx, meta)
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "listing %s: %s\n", typeName, err)
+			failed = true
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "found %d %s\n", len(ids), typeName)
+
+		slices.Sort(ids)
+		for _, id := range ids {
+			blocks = append(blocks, importBlock{TypeName: typeName, ID: id})
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *outputFile != "" {
+		f, err := os.Create(*outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if _, err := w.Write(renderImportBlocks(blocks)); err != nil {
+		return err
+	}
+
+	if failed {
+		return errors.New("listing at least one resource type failed")
+	}
+
+	return nil
+}
+
+// resourceListers returns the resource listers implemented by the specified service packages, keyed by resource type.
+// Each lister's resource type must be a registered resource that supports import.
+func resourceListers(ctx context.Context, p *schema.Provider, servicePackages []conns.ServicePackage) (map[string]*types.ServicePackageResourceLister, error) {
+	listers := make(map[string]*types.ServicePackageResourceLister)
+
+	for _, sp := range servicePackages {
+		v, ok := sp.(conns.ServicePackageWithResourceListers)
+		if !ok {
+			continue
+		}
+
+		for _, lister := range v.ResourceListers(ctx) {
+			typeName := lister.TypeName
+
+			importable, err := isImportable(ctx, p, sp, typeName)
+			if err != nil {
+				return nil, err
+			}
+			if !importable {
+				return nil, fmt.Errorf("resource type %s has a lister but is not a registered resource that supports import", typeName)
+			}
+
+			if _, ok := listers[typeName]; ok {
+				return nil, fmt.Errorf("duplicate lister for resource type %s", typeName)
+			}
+			listers[typeName] = lister
+		}
+	}
+
+	return listers, nil
+}
+
+// isImportable returns whether the specified resource type is registered by the service package and has an importer.
+func isImportable(ctx context.Context, p *schema.Provider, sp conns.ServicePackage, typeName string) (bool, error) {
+	for _, v := range sp.SDKResources(ctx) {
+		if v.TypeName == typeName {
+			r, ok := p.ResourcesMap[typeName]
+			return ok && r.Importer != nil, nil
+		}
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		if v.TypeName == typeName {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				return false, fmt.Errorf("creating resource %s: %w", typeName, err)
+			}
+			_, ok := r.(fwresource.ResourceWithImportState)
+			return ok, nil
+		}
+	}
+
+	return false, nil
+}
+
+// selectTypeNames returns the resource types that match any of the specified patterns.
+// Each pattern must match at least one resource type.
+func selectTypeNames(typeNames []string, patterns []string) ([]string, error) {
+	var selected []string
+
+	for _, pattern := range patterns {
+		var matched bool
+
+		for _, typeName := range typeNames {
+			ok, err := path.Match(pattern, typeName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid resource type pattern %q: %w", pattern, err)
+			}
+			if ok {
+				matched = true
+				if !slices.Contains(selected, typeName) {
+					selected = append(selected, typeName)
+				}
+			}
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("no resource types that can be enumerated match %q, run with -list to see supported resource types", pattern)
+		}
+	}
+
+	slices.Sort(selected)
+
+	return selected, nil
+}
@@ -11,11 +11,15 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -57,16 +61,13 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
-
-	if err != nil {
-		g.Fatalf(err.Error())
-	}
+	ctx := context.Background()
 
+	// Use the service packages' resource factories directly so that the Plugin SDK CRUD functions aren't wrapped.
 	if v := *dataSourceType; v != "" {
-		resource, ok := p.DataSourcesMap[v]
+		resource := findSDKDataSource(ctx, v)
 
-		if !ok {
+		if resource == nil {
 			g.Fatalf("data source type %s not found", v)
 		}
 
@@ -75,9 +76,9 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		resource := findSDKResource(ctx, v)
 
-		if !ok {
+		if resource == nil {
 			g.Fatalf("resource type %s not found", v)
 		}
 
@@ -91,6 +92,30 @@ func main() {
 	}
 }
 
+func findSDKDataSource(ctx context.Context, typeName string) *schema.Resource {
+	for _, sp := range provider.ServicePackages(ctx) {
+		for _, v := range sp.SDKDataSources(ctx) {
+			if v.TypeName == typeName {
+				return v.Factory()
+			}
+		}
+	}
+
+	return nil
+}
+
+func findSDKResource(ctx context.Context, typeName string) *schema.Resource {
+	for _, sp := range provider.ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v.Factory()
+			}
+		}
+	}
+
+	return nil
+}
+
 type migrator struct {
 	Generator    *common.Generator
 	IsDataSource bool
@@ -101,7 +126,7 @@ type migrator struct {
 	TFTypeName   string
 }
 
-// migrate generates an identical schema into the specified output file.
+// migrate generates an identical schema, a resource model and CRUD stubs into the specified output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -129,37 +154,97 @@ func (m *migrator) migrate(outputFilename string) error {
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	service, err := data.LookupService(m.PackageName)
+
+	if err != nil {
+		return nil, fmt.Errorf("looking up service: %w", err)
+	}
+
+	if _, ok := m.Resource.Schema["id"]; ok {
+		m.warnf("Explicit `id` attribute defined")
+	} else {
+		m.Resource.Schema["id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: m.IsDataSource,
+			Computed: true,
+		}
+	}
+
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:     m.Generator,
+		IsDataSource:  m.IsDataSource,
+		SchemaVersion: m.Resource.SchemaVersion,
+		SchemaWriter:  &sbSchema,
+		StructWriter:  &sbStruct,
 	}
 
-	err := emitter.emitSchemaForResource(m.Resource)
+	// Migrated resources start at the next schema version so that state written by the Plugin SDK resource is upgraded.
+	if !m.IsDataSource {
+		emitter.SchemaVersion++
+	}
 
-	if err != nil {
+	if err := emitter.emitSchemaForResource(m.Resource); err != nil {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		ClientName:                   service.ProviderNameUpper() + "Client",
+		HasTags:                      emitter.HasTopLevelTagsMap,
 		HasTimeouts:                  emitter.HasTimeouts,
+		HumanFriendlyName:            serviceHumanFriendly(service) + " " + naming.ToHumanFriendly(m.Name),
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportTags:                   emitter.ImportTags,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		ReadFunc:                     funcName(m.Resource.Read, m.Resource.ReadContext, m.Resource.ReadWithoutTimeout),
 		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
+		SDKPackage:                   service.GoV2Package(),
+		ResourceName:                 naming.ToHumanFriendly(m.Name),
+		Struct:                       strings.TrimSpace(sbStruct.String()),
 		TFTypeName:                   m.TFTypeName,
+		TypeName:                     naming.ToLowerCamelCase(m.Name),
+	}
+
+	if v := m.Resource.Timeouts; v != nil {
+		templateData.DefaultCreateTimeout = durationExpr(v.Create)
+		templateData.DefaultReadTimeout = durationExpr(v.Read)
+		templateData.DefaultUpdateTimeout = durationExpr(v.Update)
+		templateData.DefaultDeleteTimeout = durationExpr(v.Delete)
+	}
+
+	if !m.IsDataSource {
+		templateData.CreateFunc = funcName(m.Resource.Create, m.Resource.CreateContext, m.Resource.CreateWithoutTimeout)
+		// Tags are handled transparently by the Plugin Framework resource.
+		if f := funcName(m.Resource.CustomizeDiff); f != "SetTagsDiff" {
+			templateData.CustomizeDiffFunc = f
+		}
+		templateData.DeleteFunc = funcName(m.Resource.Delete, m.Resource.DeleteContext, m.Resource.DeleteWithoutTimeout)
+		templateData.UpdateFunc = funcName(m.Resource.Update, m.Resource.UpdateContext, m.Resource.UpdateWithoutTimeout)
+
+		if v := m.Resource.Importer; v != nil {
+			// The passthrough importer maps directly onto framework.WithImportByID.
+			if f := funcName(v.State, v.StateContext); f == funcName(schema.ImportStatePassthroughContext) {
+				templateData.ImportByID = true
+			} else {
+				templateData.ImportStateFunc = f
+				templateData.EmitResourceImportState = true
+			}
+		}
+
+		if err := m.generateStateUpgradeData(templateData, emitter); err != nil {
+			return nil, err
+		}
+
+		for _, v := range m.Resource.StateUpgraders {
+			templateData.SDKStateUpgraders = append(templateData.SDKStateUpgraders, sdkStateUpgrader{
+				Function: funcName(v.Upgrade),
+				Version:  v.Version,
+			})
+		}
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -181,15 +266,94 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// generateStateUpgradeData emits the Plugin SDK resource's schema, without any custom types, as the prior schema
+// and works out how each field in the prior state is converted to the resource model.
+func (m *migrator) generateStateUpgradeData(templateData *templateData, typed *emitter) error {
+	sbSchema := strings.Builder{}
+	sbStruct := strings.Builder{}
+	emitter := &emitter{
+		Generator:     m.Generator,
+		IsDataSource:  m.IsDataSource,
+		Plain:         true,
+		SchemaVersion: m.Resource.SchemaVersion,
+		SchemaWriter:  &sbSchema,
+		StructWriter:  &sbStruct,
+	}
+
+	if err := emitter.emitSchemaForResource(m.Resource); err != nil {
+		return fmt.Errorf("emitting prior schema code: %w", err)
+	}
+
+	templateData.PriorSchema = sbSchema.String()
+	templateData.PriorStruct = strings.TrimSpace(sbStruct.String())
+	templateData.PriorSchemaVersion = m.Resource.SchemaVersion
+	templateData.SchemaVersion = typed.SchemaVersion
+
+	for i, new := range typed.Fields {
+		old := emitter.Fields[i]
+		field := stateUpgradeField{
+			GoName: new.GoName,
+		}
+
+		switch {
+		case new.GoType == old.GoType:
+			field.Value = "oldState." + old.GoName
+			// The Plugin SDK stores the zero value for unset attributes.
+			if new.GoType == "types.String" && new.Optional && !new.Computed {
+				field.Value = fmt.Sprintf("fwflex.EmptyStringAsNull(%s)", field.Value)
+			}
+		case new.GoType == "fwtypes.ARN":
+			field.ARN = true
+			field.Value = fmt.Sprintf("fwtypes.ARNValue(oldState.%s.ValueString())", old.GoName)
+		case new.GoType == "fwtypes.ListOfString":
+			field.Value = fmt.Sprintf("fwtypes.ListValueOf[types.String]{ListValue: oldState.%s}", old.GoName)
+		case new.GoType == "fwtypes.MapOfString":
+			field.Value = fmt.Sprintf("fwtypes.MapValueOf[types.String]{MapValue: oldState.%s}", old.GoName)
+		case new.GoType == "fwtypes.SetOfString":
+			field.Value = fmt.Sprintf("fwtypes.SetValueOf[types.String]{SetValue: oldState.%s}", old.GoName)
+		case strings.HasPrefix(new.GoType, "fwtypes.ListNestedObjectValueOf["):
+			field.Value = fmt.Sprintf("fwtypes.NewListNestedObjectValueOfNull[%s](ctx)", new.ModelName)
+			field.TODO = fmt.Sprintf("Convert oldState.%s (%s). Until then the value is refreshed by Read.", old.GoName, old.GoType)
+		case strings.HasPrefix(new.GoType, "fwtypes.SetNestedObjectValueOf["):
+			field.Value = fmt.Sprintf("fwtypes.NewSetNestedObjectValueOfNull[%s](ctx)", new.ModelName)
+			field.TODO = fmt.Sprintf("Convert oldState.%s (%s). Until then the value is refreshed by Read.", old.GoName, old.GoType)
+		default:
+			field.TODO = fmt.Sprintf("Convert oldState.%s (%s) to %s.", old.GoName, old.GoType, new.GoType)
+		}
+
+		templateData.StateUpgradeFields = append(templateData.StateUpgradeFields, field)
+	}
+
+	for _, v := range emitter.FrameworkPlanModifierPackages {
+		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
+			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
+		}
+	}
+	for _, v := range emitter.FrameworkValidatorsPackages {
+		if !slices.Contains(templateData.FrameworkValidatorsPackages, v) {
+			templateData.FrameworkValidatorsPackages = append(templateData.FrameworkValidatorsPackages, v)
+		}
+	}
+	for _, v := range emitter.GoImports {
+		if !slices.Contains(templateData.GoImports, v) {
+			templateData.GoImports = append(templateData.GoImports, v)
+		}
+	}
+	templateData.ImportFrameworkAttr = templateData.ImportFrameworkAttr || emitter.ImportFrameworkAttr
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
 
+func (m *migrator) warnf(format string, a ...any) {
+	m.Generator.Warnf(format, a...)
+}
+
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	Fields                        []field // Top-level fields of the emitted struct.
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -199,49 +363,28 @@ type emitter struct {
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
 	IsDataSource                  bool
+	Models                        []model // Nested models, in emission order.
+	Plain                         bool    // Emit only terraform-plugin-framework base types, e.g. for a prior schema.
+	SchemaVersion                 int
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
-	if _, ok := resource.Schema["id"]; ok {
-		e.warnf("Explicit `id` attribute defined")
-	} else {
-		resource.Schema["id"] = &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: e.IsDataSource,
-			Computed: true,
-		}
-	}
-
-	if v := resource.Timeouts; v != nil {
-		e.HasTimeouts = true
-
-		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
-		}
-		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
-		}
-		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
-		}
-		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
-		}
-	}
+	e.HasTimeouts = resource.Timeouts != nil
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, resource.Schema, e.StructWriter)
 
 	if err != nil {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
+	if version := e.SchemaVersion; version > 0 {
 		fprintf(e.SchemaWriter, "Version:%d,\n", version)
 	}
 
@@ -260,8 +403,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
 // and emits the generated code to the emitter's Writer.
+// The corresponding model fields are emitted to structWriter, if any.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema, structWriter io.Writer) error {
 	isTopLevelAttribute := len(path) == 0
 
 	// At this point we are emitting code for a schema.Block or Schema.
@@ -295,21 +439,18 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
+		var goType string
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			goType = "types.String"
 		} else {
-			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
+			var err error
+			if goType, err = e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		e.emitField(structWriter, path, name, goType, property)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -332,12 +473,14 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		goType, err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		e.emitField(structWriter, path, name, goType, property)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -347,14 +490,40 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 	return nil
 }
 
+// emitField emits a model field to structWriter, if any, and records top-level fields.
+func (e *emitter) emitField(structWriter io.Writer, path []string, name, goType string, property *schema.Schema) {
+	if structWriter == nil {
+		return
+	}
+
+	goName := naming.ToCamelCase(name)
+
+	fprintf(structWriter, "%s %s `tfsdk:%q`\n", goName, goType, name)
+
+	if len(path) == 0 {
+		field := field{
+			Computed: property.Computed,
+			GoName:   goName,
+			GoType:   goType,
+			Name:     name,
+			Optional: property.Optional,
+		}
+		if i, j := strings.Index(goType, "["), strings.LastIndex(goType, "]"); i > 0 && j > i {
+			field.ModelName = goType[i+1 : j]
+		}
+		e.Fields = append(e.Fields, field)
+	}
+}
+
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// The Go type of the corresponding model field is returned.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) (string, error) {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
-	var defaultSpec string
+	var defaultSpec, goType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
@@ -365,50 +534,37 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
-
+		goType = "types.Bool"
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
-
+		goType = "types.Float64"
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
-
+		goType = "types.Int64"
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly && !e.Plain {
 			e.ImportProviderFrameworkTypes = true
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			goType = "fwtypes.ARN"
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			goType = "types.String"
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -424,10 +580,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			goType = "types.List"
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -437,10 +590,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			goType = "types.Map"
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -450,10 +600,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			goType = "types.Set"
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -463,7 +610,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			var customType, elementType string
 
 			switch v := v.Type; v {
 			case schema.TypeBool:
@@ -478,44 +625,90 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			case schema.TypeString:
 				elementType = "types.StringType"
 				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
+				if typeName == "map" && isTopLevelAttribute && (attributeName == "tags" || attributeName == "tags_all") {
 					if attributeName == "tags" {
 						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
+					} else {
 						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
+					}
+					e.ImportTags = true
+
+					switch {
+					case attributeName == "tags_all" && !e.IsDataSource:
+						fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+						return "tftags.Map", nil
+					case property.Optional && !property.Computed && !e.IsDataSource:
+						fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+						return "tftags.Map", nil
+					case property.Required && !e.IsDataSource:
+						fprintf(e.SchemaWriter, "tftags.TagsAttributeRequired()")
+						return "tftags.Map", nil
+					case isComputedOnly:
+						fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+						return "tftags.Map", nil
+					}
+
+					customType = "tftags.MapType"
+					goType = "tftags.Map"
+				} else if !e.Plain {
+					e.ImportProviderFrameworkTypes = true
+
+					switch typeName {
+					case "list":
+						customType = "fwtypes.ListOfStringType"
+						goType = "fwtypes.ListOfString"
+					case "map":
+						customType = "fwtypes.MapOfStringType"
+						goType = "fwtypes.MapOfString"
+					case "set":
+						customType = "fwtypes.SetOfStringType"
+						goType = "fwtypes.SetOfString"
 					}
 				}
 
 			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+				return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			if customType != "" {
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			}
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+			if !e.Plain && typeName != "map" {
+				modelName, err := e.emitComputedOnlyModel(path, v.Schema)
+
+				if err != nil {
+					return "", err
+				}
+
+				e.ImportProviderFrameworkTypes = true
+				collectionType := naming.ToCamelCase(typeName)
+				goType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", collectionType, modelName)
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collectionType, modelName)
+				fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+			} else {
+				fprintf(e.SchemaWriter, "ElementType:")
+
+				if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+					return "", err
+				}
 
-			fprintf(e.SchemaWriter, ",\n")
+				fprintf(e.SchemaWriter, ",\n")
+			}
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	if property.Required {
@@ -611,13 +804,15 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// The Go type of the corresponding model field is returned.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) (string, error) {
 	var planModifiers []string
+	var goType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Block or Schema's Blocks (map[string]schema.Block).
@@ -625,54 +820,62 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	//
 	// Complex types.
 	//
-	case schema.TypeList:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
+	case schema.TypeList, schema.TypeSet:
+		var collectionType string
+
+		switch v {
+		case schema.TypeList:
+			collectionType = "List"
 			fwPlanModifierPackage = "listplanmodifier"
-			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
-			fwValidatorType = "List"
-
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
-			}
-
-			fprintf(e.SchemaWriter, "},\n")
-
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
+		case schema.TypeSet:
+			collectionType = "Set"
+			fwPlanModifierPackage = "setplanmodifier"
+			fwValidatorsPackage = "setvalidator"
 		}
+		fwPlanModifierType = collectionType
+		fwValidatorType = collectionType
 
-	case schema.TypeSet:
 		switch v := property.Elem.(type) {
 		case *schema.Resource:
-			fwPlanModifierPackage = "setplanmodifier"
-			fwPlanModifierType = "Set"
-			fwValidatorsPackage = "setvalidator"
-			fwValidatorType = "Set"
+			fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", collectionType)
+
+			var structWriter io.Writer
+			if e.Plain {
+				goType = "types." + collectionType
+			} else {
+				modelName := e.newModelName(path)
+				sb := &strings.Builder{}
+				structWriter = sb
+				goType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", collectionType, modelName)
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collectionType, modelName)
+
+				// Reserve the model's position so that parent models precede their children.
+				i := len(e.Models)
+				e.Models = append(e.Models, model{Name: modelName})
+				defer func() {
+					e.Models[i].Struct = strings.TrimSpace(sb.String())
+				}()
+			}
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitAttributesAndBlocks(path, v.Schema, structWriter)
 
 			if err != nil {
-				return err
+				return "", err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) %s of %T", strings.ToLower(collectionType), v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	// Compatibility hacks.
@@ -728,7 +931,7 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
 }
 
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
@@ -854,6 +1057,103 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// emitComputedOnlyModel generates the model for a Plugin SDK Computed-only nested block.
+// The name of the generated model is returned.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitComputedOnlyModel(path []string, attributes map[string]*schema.Schema) (string, error) {
+	modelName := e.newModelName(path)
+
+	// Reserve the model's position so that parent models precede their children.
+	i := len(e.Models)
+	e.Models = append(e.Models, model{Name: modelName})
+
+	names := make([]string, 0)
+	for name := range attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	sb := strings.Builder{}
+	for _, name := range names {
+		property := attributes[name]
+		var goType string
+
+		switch v := property.Type; v {
+		case schema.TypeBool:
+			goType = "types.Bool"
+
+		case schema.TypeFloat:
+			goType = "types.Float64"
+
+		case schema.TypeInt:
+			goType = "types.Int64"
+
+		case schema.TypeString:
+			goType = "types.String"
+
+		case schema.TypeList, schema.TypeMap, schema.TypeSet:
+			collectionType := strings.TrimPrefix(v.String(), "Type") // e.g. List
+
+			switch v := property.Elem.(type) {
+			case *schema.Schema:
+				if v.Type == schema.TypeString {
+					goType = fmt.Sprintf("fwtypes.%sOfString", collectionType)
+				} else {
+					// TODO Add support for collections of other primitive types.
+					goType = "types." + collectionType
+					fprintf(&sb, "// TODO Use a custom type for %s of %s.\n", collectionType, v.Type.String())
+				}
+
+			case *schema.Resource:
+				if collectionType == "Map" {
+					return "", unsupportedTypeError(append(path, name), "(ComputedOnlyModel) map of *schema.Resource")
+				}
+
+				nestedModelName, err := e.emitComputedOnlyModel(append(path, name), v.Schema)
+
+				if err != nil {
+					return "", err
+				}
+
+				goType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", collectionType, nestedModelName)
+
+			default:
+				return "", unsupportedTypeError(append(path, name), fmt.Sprintf("(ComputedOnlyModel) %s of %T", collectionType, v))
+			}
+
+		default:
+			return "", unsupportedTypeError(append(path, name), v.String())
+		}
+
+		fprintf(&sb, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), goType, name)
+	}
+
+	e.Models[i].Struct = strings.TrimSpace(sb.String())
+
+	return modelName, nil
+}
+
+// newModelName returns a unique model name for the nested block at the specified path.
+func (e *emitter) newModelName(path []string) string {
+	isUnique := func(name string) bool {
+		return !slices.ContainsFunc(e.Models, func(m model) bool {
+			return m.Name == name
+		})
+	}
+
+	name := naming.ToLowerCamelCase(path[len(path)-1]) + "Model"
+	if isUnique(name) {
+		return name
+	}
+
+	name = naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+	for i := 2; !isUnique(name); i++ {
+		name = fmt.Sprintf("%s%dModel", naming.ToLowerCamelCase(strings.Join(path, "_")), i)
+	}
+
+	return name
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -901,25 +1201,116 @@ func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// serviceHumanFriendly returns the service's human-friendly name without any expansion, e.g. ECR.
+func serviceHumanFriendly(service data.ServiceRecord) string {
+	name := service.HumanFriendly()
+
+	if i := strings.Index(name, " ("); i > 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// funcName returns the name, without package, of the first non-nil function, e.g. resourceJobQueueCreate.
+func funcName(fs ...any) string {
+	for _, f := range fs {
+		if v := reflect.ValueOf(f); v.Kind() == reflect.Func && !v.IsNil() {
+			name := runtime.FuncForPC(v.Pointer()).Name()
+			name = name[strings.LastIndex(name, "/")+1:] // Strip the package path.
+			return name[strings.Index(name, ".")+1:]     // Strip the package name.
+		}
+	}
+
+	return ""
+}
+
+// durationExpr returns a human-friendly Go expression for the specified duration, e.g. 20 * time.Minute.
+func durationExpr(d *time.Duration) string {
+	if d == nil || *d <= 0 {
+		return ""
+	}
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if *d%v.unit == 0 {
+			return fmt.Sprintf("%d * %s", *d/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Millisecond", *d/time.Millisecond)
+}
+
+type field struct {
+	Computed  bool
+	GoName    string // e.g. JobQueueName
+	GoType    string // e.g. types.String
+	ModelName string // Name of any nested model, e.g. computeEnvironmentOrderModel
+	Name      string // e.g. name
+	Optional  bool
+}
+
+type model struct {
+	Name   string // e.g. computeEnvironmentOrderModel
+	Struct string
+}
+
+type sdkStateUpgrader struct {
+	Function string // e.g. resourceJobQueueStateUpgradeV0
+	Version  int
+}
+
+type stateUpgradeField struct {
+	ARN    bool   // An empty prior value is converted to null.
+	GoName string // e.g. JobQueueARN
+	TODO   string
+	Value  string // Go expression for the upgraded value.
+}
+
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	ClientName                    string // e.g. BatchClient
+	CreateFunc                    string // e.g. resourceJobQueueCreate
+	CustomizeDiffFunc             string
+	DefaultCreateTimeout          string // e.g. 20 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	DeleteFunc                    string
 	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
-	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasTags                       bool
 	HasTimeouts                   bool
+	HumanFriendlyName             string // e.g. Batch Job Queue
+	ImportByID                    bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportStateFunc               string
+	ImportTags                    bool
+	Models                        []model
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	PriorSchema                   string
+	PriorSchemaVersion            int
+	PriorStruct                   string
+	ReadFunc                      string
+	ResourceName                  string // e.g. Job Queue
 	Schema                        string
+	SchemaVersion                 int
+	SDKPackage                    string // e.g. batch
+	SDKStateUpgraders             []sdkStateUpgrader
+	StateUpgradeFields            []stateUpgradeField
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	TypeName                      string // e.g. instance
+	UpdateFunc                    string
 }
 
 //go:embed datasource.gtpl
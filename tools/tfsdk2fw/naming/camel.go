@@ -45,6 +45,40 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism, e.g. ARN or VPC, is lowercased in its entirety.
+func ToLowerCamelCase(s string) string {
+	b := []byte(ToCamelCase(s))
+
+	for i := range b {
+		if !isCapitalLetter(b[i]) {
+			break
+		}
+		// Keep the capital letter that starts the next word, e.g. "ARNConfig" -> "arnConfig".
+		if i > 0 && i+1 < len(b) && isLowercaseLetter(b[i+1]) {
+			break
+		}
+		b[i] = toLowercaseLetter(b[i])
+	}
+
+	return string(b)
+}
+
+// ToHumanFriendly converts a CamelCase string to space-separated words, e.g. "JobQueue" -> "Job Queue".
+func ToHumanFriendly(s string) string {
+	c := strings.Builder{}
+
+	b := []byte(s)
+	for i, ch := range b {
+		if i > 0 && isCapitalLetter(ch) && (isLowercaseLetter(b[i-1]) || (i+1 < len(b) && isLowercaseLetter(b[i+1]) && isCapitalLetter(b[i-1]))) {
+			c.WriteByte(' ')
+		}
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
@@ -62,3 +96,9 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+func toLowercaseLetter(ch byte) byte {
+	ch += 'a'
+	ch -= 'A'
+	return ch
+}
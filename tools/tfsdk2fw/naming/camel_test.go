@@ -67,3 +67,91 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToLowerCamelCase(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "description",
+			ExpectedValue: "description",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "health_check_config",
+			ExpectedValue: "healthCheckConfig",
+		},
+		{
+			TestName:      "ID",
+			Value:         "id",
+			ExpectedValue: "id",
+		},
+		{
+			TestName:      "leading ARN",
+			Value:         "ARNConfig",
+			ExpectedValue: "arnConfig",
+		},
+		{
+			TestName:      "CamelCase",
+			Value:         "JobQueue",
+			ExpectedValue: "jobQueue",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToLowerCamelCase(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestToHumanFriendly(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Instance",
+			ExpectedValue: "Instance",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "JobQueue",
+			ExpectedValue: "Job Queue",
+		},
+		{
+			TestName:      "initialism",
+			Value:         "VPCEndpointService",
+			ExpectedValue: "VPC Endpoint Service",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToHumanFriendly(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}